@@ -43,6 +43,10 @@ var Commands = []Command{
 	{"delete-task", "dt", "Delete a task", cmdDeleteTask, true},
 	{"list-projects", "lp", "List all projects", cmdListProjects, true},
 	{"search-projects", "sp", "Search projects by name", cmdSearchProjects, true},
+	{"list-tags", "lg", "List all tags", cmdListTags, true},
+	{"list-areas", "la", "List all areas", cmdListAreas, true},
+	{"list-notes", "ln", "List all notes", cmdListNotes, true},
+	{"list-inbox", "li", "List inbox items", cmdListInbox, true},
 }
 
 func main() {
@@ -174,6 +178,22 @@ func cmdShowHelp(ctx *PlaygroundContext, scanner *bufio.Scanner) {
 	fmt.Println("    Search projects by name (case-insensitive)")
 	fmt.Println("    Safe to use in readonly mode")
 	fmt.Println()
+	fmt.Println("  list-tags, lg")
+	fmt.Println("    Lists all tags")
+	fmt.Println("    Safe to use in readonly mode")
+	fmt.Println()
+	fmt.Println("  list-areas, la")
+	fmt.Println("    Lists all areas")
+	fmt.Println("    Safe to use in readonly mode")
+	fmt.Println()
+	fmt.Println("  list-notes, ln")
+	fmt.Println("    Lists all notes")
+	fmt.Println("    Safe to use in readonly mode")
+	fmt.Println()
+	fmt.Println("  list-inbox, li")
+	fmt.Println("    Lists unprocessed inbox items")
+	fmt.Println("    Safe to use in readonly mode")
+	fmt.Println()
 	fmt.Println("✍️  WRITE OPERATIONS (disabled in readonly mode):")
 	fmt.Println("  create-task, ct")
 	fmt.Println("    Creates a new task")
@@ -240,7 +260,7 @@ func cmdToggleReadonly(ctx *PlaygroundContext, scanner *bufio.Scanner) {
 
 func cmdListTasks(ctx *PlaygroundContext, scanner *bufio.Scanner) {
 	fmt.Println("📋 Fetching tasks...")
-	tasks, err := ctx.API.GetTasks()
+	tasks, err := ctx.API.GetTasks(nil)
 	if err != nil {
 		fmt.Printf("❌ Error fetching tasks: %v\n", err)
 		return
@@ -390,13 +410,13 @@ func cmdUpdateTask(ctx *PlaygroundContext, scanner *bufio.Scanner) {
 
 	req := tudidi.UpdateTaskRequest{}
 	if name != "" {
-		req.Name = name
+		req.Name = &name
 	}
 	if description != "" {
-		req.Note = description
+		req.Note = &description
 	}
 
-	if req.Name == "" && req.Note == "" {
+	if req.Name == nil && req.Note == nil {
 		fmt.Println("❌ No updates specified")
 		return
 	}
@@ -491,6 +511,94 @@ func cmdSearchProjects(ctx *PlaygroundContext, scanner *bufio.Scanner) {
 	displayProjects(projects)
 }
 
+func cmdListTags(ctx *PlaygroundContext, scanner *bufio.Scanner) {
+	fmt.Println("🏷️  Fetching tags...")
+	tags, err := ctx.API.GetTags()
+	if err != nil {
+		fmt.Printf("❌ Error fetching tags: %v\n", err)
+		return
+	}
+
+	if len(tags) == 0 {
+		fmt.Println("📝 No tags found")
+		return
+	}
+
+	fmt.Printf("✅ Found %d tag(s):\n", len(tags))
+	fmt.Println("ID   | Name")
+	fmt.Println("-----|-------------------------")
+	for _, tag := range tags {
+		fmt.Printf("%-4d | %s\n", tag.ID, tag.Name)
+	}
+}
+
+func cmdListAreas(ctx *PlaygroundContext, scanner *bufio.Scanner) {
+	fmt.Println("🗂️  Fetching areas...")
+	areas, err := ctx.API.GetAreas()
+	if err != nil {
+		fmt.Printf("❌ Error fetching areas: %v\n", err)
+		return
+	}
+
+	if len(areas) == 0 {
+		fmt.Println("📝 No areas found")
+		return
+	}
+
+	fmt.Printf("✅ Found %d area(s):\n", len(areas))
+	fmt.Println("ID   | Name                     | Description")
+	fmt.Println("-----|--------------------------|------------")
+	for _, area := range areas {
+		name := truncateString(area.Name, 24)
+		desc := truncateString(area.Description, 20)
+		fmt.Printf("%-4d | %-24s | %s\n", area.ID, name, desc)
+	}
+}
+
+func cmdListNotes(ctx *PlaygroundContext, scanner *bufio.Scanner) {
+	fmt.Println("🗒️  Fetching notes...")
+	notes, err := ctx.API.GetNotes()
+	if err != nil {
+		fmt.Printf("❌ Error fetching notes: %v\n", err)
+		return
+	}
+
+	if len(notes) == 0 {
+		fmt.Println("📝 No notes found")
+		return
+	}
+
+	fmt.Printf("✅ Found %d note(s):\n", len(notes))
+	fmt.Println("ID   | Title                    | Project ID | Updated")
+	fmt.Println("-----|--------------------------|------------|--------")
+	for _, note := range notes {
+		title := truncateString(note.Title, 24)
+		fmt.Printf("%-4d | %-24s | %-10d | %s\n", note.ID, title, note.ProjectID, formatDate(note.UpdatedAt))
+	}
+}
+
+func cmdListInbox(ctx *PlaygroundContext, scanner *bufio.Scanner) {
+	fmt.Println("📥 Fetching inbox...")
+	items, err := ctx.API.GetInboxItems()
+	if err != nil {
+		fmt.Printf("❌ Error fetching inbox: %v\n", err)
+		return
+	}
+
+	if len(items) == 0 {
+		fmt.Println("📝 Inbox is empty")
+		return
+	}
+
+	fmt.Printf("✅ Found %d inbox item(s):\n", len(items))
+	fmt.Println("ID   | Content                                  | Captured")
+	fmt.Println("-----|------------------------------------------|---------")
+	for _, item := range items {
+		content := truncateString(item.Content, 40)
+		fmt.Printf("%-4d | %-40s | %s\n", item.ID, content, formatDate(item.CreatedAt))
+	}
+}
+
 // Helper Functions
 
 func displayProjects(projects []tudidi.Project) {
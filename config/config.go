@@ -5,6 +5,15 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported transports; "http" is accepted as an alias for TransportStreamable
+const (
+	TransportStdio      = "stdio"
+	TransportSSE        = "sse"
+	TransportStreamable = "streamable"
 )
 
 type Config struct {
@@ -14,6 +23,13 @@ type Config struct {
 	Readonly  bool
 	Transport string
 	Port      int
+	Timezone  string
+	// PollInterval is how often subscribed resources are checked for changes; 0 disables subscriptions
+	PollInterval time.Duration
+	// BasePath is the URL path of the streamable HTTP endpoint
+	BasePath string
+	// LegacySSE also serves the SSE endpoint at BasePath/sse alongside the streamable transport
+	LegacySSE bool
 }
 
 func ParseArgs() (*Config, error) {
@@ -23,8 +39,12 @@ func ParseArgs() (*Config, error) {
 	flag.StringVar(&config.Email, "email", "", "Email for authentication (required)")
 	flag.StringVar(&config.Password, "password", "", "Password for authentication (required)")
 	flag.BoolVar(&config.Readonly, "readonly", true, "Run in readonly mode (prevents destructive operations)")
-	flag.StringVar(&config.Transport, "transport", "stdio", "Transport type: 'stdio' or 'sse'")
-	flag.IntVar(&config.Port, "port", 8080, "Port for SSE transport (ignored for stdio)")
+	flag.StringVar(&config.Transport, "transport", "stdio", "Transport type: 'stdio', 'sse' or 'streamable' (alias 'http')")
+	flag.IntVar(&config.Port, "port", 8080, "Port for HTTP transports (ignored for stdio)")
+	flag.StringVar(&config.BasePath, "base-path", "/mcp", "URL path of the streamable HTTP endpoint")
+	flag.BoolVar(&config.LegacySSE, "legacy-sse", false, "Also serve the SSE endpoint at <base-path>/sse for older clients (streamable transport only)")
+	flag.StringVar(&config.Timezone, "timezone", "", "IANA timezone for relative dates such as 'tomorrow' (default: local timezone)")
+	flag.DurationVar(&config.PollInterval, "poll-interval", 30*time.Second, "How often to check subscribed resources for changes, e.g. 30s or 2m (0 disables subscriptions)")
 
 	flag.Parse()
 
@@ -49,35 +69,102 @@ func ParseArgs() (*Config, error) {
 			config.Port = port
 		}
 	}
-
-	if config.URL == "" {
-		return nil, fmt.Errorf("URL is required (use --url flag or TUDIDI_URL environment variable)")
+	if envTimezone := os.Getenv("TUDIDI_TIMEZONE"); envTimezone != "" {
+		config.Timezone = envTimezone
 	}
-	if config.Email == "" {
-		return nil, fmt.Errorf("email is required (use --email flag or TUDIDI_USER_EMAIL environment variable)")
+	if envBasePath := os.Getenv("TUDIDI_BASE_PATH"); envBasePath != "" {
+		config.BasePath = envBasePath
 	}
-	if config.Password == "" {
-		return nil, fmt.Errorf("password is required (use --password flag or TUDIDI_USER_PASSWORD environment variable)")
+	if envLegacySSE := os.Getenv("TUDIDI_LEGACY_SSE"); envLegacySSE != "" {
+		config.LegacySSE = envLegacySSE == "true"
 	}
-	if config.Transport != "stdio" && config.Transport != "sse" {
-		return nil, fmt.Errorf("transport must be 'stdio' or 'sse', got: %s", config.Transport)
+	if envPollInterval := os.Getenv("TUDIDI_POLL_INTERVAL"); envPollInterval != "" {
+		if interval, err := time.ParseDuration(envPollInterval); err == nil {
+			config.PollInterval = interval
+		}
 	}
-	if config.Port <= 0 || config.Port > 65535 {
-		return nil, fmt.Errorf("port must be between 1 and 65535, got: %d", config.Port)
+
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
 
+// Validate checks the configuration, resolving the "http" transport alias and
+// trailing slashes in BasePath
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return fmt.Errorf("URL is required (use --url flag or TUDIDI_URL environment variable)")
+	}
+	if c.Email == "" {
+		return fmt.Errorf("email is required (use --email flag or TUDIDI_USER_EMAIL environment variable)")
+	}
+	if c.Password == "" {
+		return fmt.Errorf("password is required (use --password flag or TUDIDI_USER_PASSWORD environment variable)")
+	}
+
+	if c.Transport == "http" {
+		c.Transport = TransportStreamable
+	}
+	if c.Transport != TransportStdio && c.Transport != TransportSSE && c.Transport != TransportStreamable {
+		return fmt.Errorf("transport must be 'stdio', 'sse' or 'streamable', got: %s", c.Transport)
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535, got: %d", c.Port)
+	}
+	if c.PollInterval < 0 {
+		return fmt.Errorf("poll interval cannot be negative, got: %s", c.PollInterval)
+	}
+
+	if c.Transport == TransportStreamable {
+		if !strings.HasPrefix(c.BasePath, "/") {
+			return fmt.Errorf("base path must start with '/', got: %s", c.BasePath)
+		}
+		if c.BasePath != "/" {
+			c.BasePath = strings.TrimRight(c.BasePath, "/")
+		}
+	}
+	if c.LegacySSE && c.Transport != TransportStreamable {
+		return fmt.Errorf("legacy SSE endpoint requires the streamable transport, got: %s", c.Transport)
+	}
+
+	if _, err := c.Location(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SSEPath returns the path of the legacy SSE endpoint served next to the streamable endpoint
+func (c *Config) SSEPath() string {
+	return strings.TrimRight(c.BasePath, "/") + "/sse"
+}
+
+// Location returns the configured timezone, or the local timezone if none is set
+func (c *Config) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': expected an IANA name such as Europe/Berlin", c.Timezone)
+	}
+	return location, nil
+}
+
 func PrintUsage() {
-	fmt.Fprintf(os.Stderr, "Usage: %s --url <tudidi-url> --email <user> --password <pass> [--readonly] [--transport <stdio|sse>] [--port <port>]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s --url <tudidi-url> --email <user> --password <pass> [--readonly] [--transport <stdio|sse|streamable>] [--port <port>] [--base-path <path>] [--legacy-sse] [--timezone <tz>] [--poll-interval <duration>]\n", os.Args[0])
 	fmt.Fprintf(os.Stderr, "\nEnvironment Variables:\n")
 	fmt.Fprintf(os.Stderr, "  TUDIDI_URL          Tudidi server URL\n")
 	fmt.Fprintf(os.Stderr, "  TUDIDI_USER_EMAIL   Email for authentication\n")
 	fmt.Fprintf(os.Stderr, "  TUDIDI_USER_PASSWORD Password for authentication\n")
 	fmt.Fprintf(os.Stderr, "  TUDIDI_READONLY     Set to 'true' or 'false' for readonly mode (default: true)\n")
-	fmt.Fprintf(os.Stderr, "  TUDIDI_TRANSPORT    Transport type: 'stdio' or 'sse' (default: stdio)\n")
-	fmt.Fprintf(os.Stderr, "  TUDIDI_PORT         Port for SSE transport (default: 8080)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_TRANSPORT    Transport type: 'stdio', 'sse' or 'streamable' (default: stdio)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_PORT         Port for HTTP transports (default: 8080)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_BASE_PATH    URL path of the streamable HTTP endpoint (default: /mcp)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_LEGACY_SSE   Set to 'true' to also serve SSE at <base-path>/sse (default: false)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_TIMEZONE     IANA timezone for relative dates (default: local timezone)\n")
+	fmt.Fprintf(os.Stderr, "  TUDIDI_POLL_INTERVAL How often to check subscribed resources for changes (default: 30s, 0 disables)\n")
 	fmt.Fprintf(os.Stderr, "\nCommand Line Flags:\n")
 	flag.PrintDefaults()
 }
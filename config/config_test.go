@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func TestConfigValidation(t *testing.T) {
@@ -93,3 +94,85 @@ func TestEnvironmentVariables(t *testing.T) {
 		t.Errorf("Expected TUDIDI_PORT to be '3000', got '%s'", port)
 	}
 }
+
+func TestLocation(t *testing.T) {
+	config := &Config{}
+	location, err := config.Location()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if location != time.Local {
+		t.Errorf("Expected local timezone by default, got %s", location)
+	}
+
+	config.Timezone = "UTC"
+	if location, err = config.Location(); err != nil || location.String() != "UTC" {
+		t.Errorf("Expected UTC, got %v (%v)", location, err)
+	}
+
+	config.Timezone = "Mars/Olympus_Mons"
+	if _, err := config.Location(); err == nil {
+		t.Error("Expected error for unknown timezone")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		return &Config{
+			URL:       "http://localhost:3002",
+			Email:     "test@example.com",
+			Password:  "testpass",
+			Transport: TransportStdio,
+			Port:      8080,
+			BasePath:  "/mcp",
+		}
+	}
+
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("Expected valid config, got %v", err)
+	}
+
+	config := valid()
+	config.Transport = "http"
+	config.BasePath = "/tudidi/mcp/"
+	config.LegacySSE = true
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Expected streamable config to be valid, got %v", err)
+	}
+	if config.Transport != TransportStreamable {
+		t.Errorf("Expected 'http' to resolve to %s, got %s", TransportStreamable, config.Transport)
+	}
+	if config.BasePath != "/tudidi/mcp" {
+		t.Errorf("Expected trailing slash to be trimmed, got %s", config.BasePath)
+	}
+	if config.SSEPath() != "/tudidi/mcp/sse" {
+		t.Errorf("Expected SSE path /tudidi/mcp/sse, got %s", config.SSEPath())
+	}
+
+	config = valid()
+	config.Transport = TransportStreamable
+	config.BasePath = "/"
+	if err := config.Validate(); err != nil || config.BasePath != "/" || config.SSEPath() != "/sse" {
+		t.Errorf("Expected root base path to be kept, got %s and %s (%v)", config.BasePath, config.SSEPath(), err)
+	}
+
+	invalid := map[string]func(*Config){
+		"missing URL":         func(c *Config) { c.URL = "" },
+		"unknown transport":   func(c *Config) { c.Transport = "websocket" },
+		"port out of range":   func(c *Config) { c.Port = 70000 },
+		"negative poll":       func(c *Config) { c.PollInterval = -time.Second },
+		"relative base path":  func(c *Config) { c.Transport = TransportStreamable; c.BasePath = "mcp" },
+		"legacy SSE on stdio": func(c *Config) { c.LegacySSE = true },
+		"legacy SSE on sse":   func(c *Config) { c.Transport = TransportSSE; c.LegacySSE = true },
+		"unknown timezone":    func(c *Config) { c.Timezone = "Mars/Olympus_Mons" },
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			config := valid()
+			modify(config)
+			if err := config.Validate(); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
@@ -0,0 +1,408 @@
+// Package dates turns the date expressions agents tend to use ("tomorrow",
+// "next friday", "in 3 days", "end of month", "2026-10-20") into the
+// YYYY-MM-DD dates Tudidi stores.
+package dates
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layout is the date format Tudidi expects
+const Layout = "2006-01-02"
+
+// Parser resolves date expressions relative to the current day in its location
+type Parser struct {
+	location *time.Location
+	now      func() time.Time
+}
+
+// NewParser creates a parser for the given timezone; nil means the local timezone
+func NewParser(location *time.Location) *Parser {
+	if location == nil {
+		location = time.Local
+	}
+	return &Parser{location: location, now: time.Now}
+}
+
+// WithClock returns a copy of the parser that reads the current time from now
+func (p *Parser) WithClock(now func() time.Time) *Parser {
+	clone := *p
+	clone.now = now
+	return &clone
+}
+
+// Location returns the timezone dates are resolved in
+func (p *Parser) Location() *time.Location {
+	return p.location
+}
+
+// Now returns the current time in the parser's timezone
+func (p *Parser) Now() time.Time {
+	return p.now().In(p.location)
+}
+
+// Today returns midnight of the current day in the parser's timezone
+func (p *Parser) Today() time.Time {
+	now := p.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, p.location)
+}
+
+// Parse converts a date expression to a YYYY-MM-DD date. It understands:
+//
+//   - ISO dates and timestamps: 2026-10-20, 2026-10-20T09:00:00Z
+//   - today, tomorrow, yesterday
+//   - weekday names: "friday" and "this friday" are the coming Friday (today if
+//     it is Friday), "next friday" is the Friday of next week, "last friday"
+//     the most recent Friday before today
+//   - offsets: "in 3 days", "in a week", "2 weeks from now", "3 days ago"
+//   - "next week", "next month", "next year" (their first day)
+//   - "end of week", "end of month", "end of next month", "start of next week", ...
+//   - month names: "oct 20", "20 october", "october 20, 2027"; without a year
+//     the next such date on or after today is used
+//   - day-first or month-first numeric dates when only one reading is valid,
+//     such as 25/12/2026; 03/04/2026 is rejected as ambiguous
+func (p *Parser) Parse(input string) (string, error) {
+	date, err := p.parse(input)
+	if err != nil {
+		return "", err
+	}
+	return date.Format(Layout), nil
+}
+
+func (p *Parser) parse(input string) (time.Time, error) {
+	text := normalize(input)
+	if text == "" {
+		return time.Time{}, fmt.Errorf("date cannot be empty")
+	}
+	today := p.Today()
+
+	if date, ok, err := p.parseISO(text); ok {
+		return date, err
+	}
+
+	switch text {
+	case "today", "tonight", "now":
+		return today, nil
+	case "tomorrow", "tmrw", "tmr":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "next week":
+		return startOfWeek(today).AddDate(0, 0, 7), nil
+	case "next month":
+		return firstOfMonth(today).AddDate(0, 1, 0), nil
+	case "next year":
+		return time.Date(today.Year()+1, time.January, 1, 0, 0, 0, 0, p.location), nil
+	case "weekend", "this weekend":
+		return nextWeekday(today, time.Saturday), nil
+	case "next weekend":
+		return startOfWeek(today).AddDate(0, 0, 12), nil
+	}
+
+	if date, ok := parseWeekdayExpr(text, today); ok {
+		return date, nil
+	}
+	if date, ok, err := parseOffset(text, today); ok {
+		return date, err
+	}
+	if date, ok := parseBoundary(text, today); ok {
+		return date, nil
+	}
+	if date, ok, err := p.parseMonthName(text, today); ok {
+		return date, err
+	}
+	if date, ok, err := p.parseNumeric(text); ok {
+		return date, err
+	}
+
+	return time.Time{}, fmt.Errorf("could not understand date '%s': use YYYY-MM-DD or an expression such as 'tomorrow', 'next friday', 'in 3 days' or 'end of month'", strings.TrimSpace(input))
+}
+
+// normalize lowercases, collapses whitespace and drops filler words and ordinal suffixes
+func normalize(input string) string {
+	text := strings.ToLower(strings.TrimSpace(input))
+	text = strings.ReplaceAll(text, ",", " ")
+	text = ordinalSuffix.ReplaceAllString(text, "$1")
+
+	var words []string
+	for _, word := range strings.Fields(text) {
+		if word == "on" || word == "the" || word == "by" || word == "due" {
+			continue
+		}
+		words = append(words, word)
+	}
+	return strings.Join(words, " ")
+}
+
+var ordinalSuffix = regexp.MustCompile(`\b(\d{1,2})(st|nd|rd|th)\b`)
+
+var isoDate = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})(t.*)?$`)
+
+// parseISO handles YYYY-MM-DD dates and full timestamps, which are converted to the parser's timezone
+func (p *Parser) parseISO(text string) (time.Time, bool, error) {
+	match := isoDate.FindStringSubmatch(text)
+	if match == nil {
+		return time.Time{}, false, nil
+	}
+
+	if match[4] != "" {
+		stamp, err := time.Parse(time.RFC3339, strings.ToUpper(text))
+		if err != nil {
+			return time.Time{}, true, fmt.Errorf("invalid timestamp '%s': expected RFC 3339, e.g. 2026-10-20T09:00:00Z", text)
+		}
+		stamp = stamp.In(p.location)
+		return time.Date(stamp.Year(), stamp.Month(), stamp.Day(), 0, 0, 0, 0, p.location), true, nil
+	}
+
+	year, _ := strconv.Atoi(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	date, err := p.makeDate(year, month, day)
+	return date, true, err
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseWeekday converts a weekday name such as "mon" or "Monday" to a time.Weekday
+func ParseWeekday(value string) (time.Weekday, error) {
+	weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return 0, fmt.Errorf("invalid weekday '%s': expected a day name such as mon or monday", value)
+	}
+	return weekday, nil
+}
+
+func parseWeekdayExpr(text string, today time.Time) (time.Time, bool) {
+	modifier, name, found := strings.Cut(text, " ")
+	if !found {
+		modifier, name = "", text
+	}
+	weekday, ok := weekdays[name]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch modifier {
+	case "", "this", "coming", "this coming":
+		return nextWeekday(today, weekday), true
+	case "next":
+		// The given day in the week after this one, weeks starting on Monday
+		offset := (int(weekday) + 6) % 7
+		return startOfWeek(today).AddDate(0, 0, 7+offset), true
+	case "last", "past":
+		days := (int(today.Weekday()) - int(weekday) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, -days), true
+	}
+	return time.Time{}, false
+}
+
+var (
+	inOffset  = regexp.MustCompile(`^in (an?|\d+) (day|week|month|year)s?$`)
+	fromNow   = regexp.MustCompile(`^(an?|\d+) (day|week|month|year)s? (from now|from today|later)$`)
+	agoOffset = regexp.MustCompile(`^(an?|\d+) (day|week|month|year)s? ago$`)
+)
+
+// parseOffset handles "in N units", "N units from now" and "N units ago"
+func parseOffset(text string, today time.Time) (time.Time, bool, error) {
+	sign := 1
+	match := inOffset.FindStringSubmatch(text)
+	if match == nil {
+		match = fromNow.FindStringSubmatch(text)
+	}
+	if match == nil {
+		if match = agoOffset.FindStringSubmatch(text); match != nil {
+			sign = -1
+		}
+	}
+	if match == nil {
+		return time.Time{}, false, nil
+	}
+
+	amount := 1
+	if match[1] != "a" && match[1] != "an" {
+		amount, _ = strconv.Atoi(match[1])
+	}
+	if amount > 10000 {
+		return time.Time{}, true, fmt.Errorf("date offset '%s' is too large", text)
+	}
+	amount *= sign
+
+	switch match[2] {
+	case "day":
+		return today.AddDate(0, 0, amount), true, nil
+	case "week":
+		return today.AddDate(0, 0, 7*amount), true, nil
+	case "month":
+		return addMonthsClamped(today, amount), true, nil
+	default:
+		return addMonthsClamped(today, 12*amount), true, nil
+	}
+}
+
+var boundary = regexp.MustCompile(`^(end|start|beginning) of (this |next |last )?(week|month|year)$`)
+
+// parseBoundary handles "end of month", "start of next week" and similar
+func parseBoundary(text string, today time.Time) (time.Time, bool) {
+	match := boundary.FindStringSubmatch(text)
+	if match == nil {
+		return time.Time{}, false
+	}
+
+	shift := 0
+	switch strings.TrimSpace(match[2]) {
+	case "next":
+		shift = 1
+	case "last":
+		shift = -1
+	}
+
+	var start, end time.Time
+	switch match[3] {
+	case "week":
+		start = startOfWeek(today).AddDate(0, 0, 7*shift)
+		end = start.AddDate(0, 0, 6)
+	case "month":
+		start = firstOfMonth(today).AddDate(0, shift, 0)
+		end = start.AddDate(0, 1, -1)
+	default:
+		start = time.Date(today.Year()+shift, time.January, 1, 0, 0, 0, 0, today.Location())
+		end = start.AddDate(1, 0, -1)
+	}
+
+	if match[1] == "end" {
+		return end, true
+	}
+	return start, true
+}
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var (
+	monthFirst = regexp.MustCompile(`^([a-z]+)\.? (\d{1,2})(?: (\d{4}))?$`)
+	dayFirst   = regexp.MustCompile(`^(\d{1,2}) ([a-z]+)\.?(?: (\d{4}))?$`)
+)
+
+// parseMonthName handles "oct 20", "20 october 2026" and similar
+func (p *Parser) parseMonthName(text string, today time.Time) (time.Time, bool, error) {
+	var monthName, dayText, yearText string
+	if match := monthFirst.FindStringSubmatch(text); match != nil {
+		monthName, dayText, yearText = match[1], match[2], match[3]
+	} else if match := dayFirst.FindStringSubmatch(text); match != nil {
+		dayText, monthName, yearText = match[1], match[2], match[3]
+	} else {
+		return time.Time{}, false, nil
+	}
+
+	month, ok := months[monthName]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	day, _ := strconv.Atoi(dayText)
+
+	if yearText != "" {
+		year, _ := strconv.Atoi(yearText)
+		date, err := p.makeDate(year, int(month), day)
+		return date, true, err
+	}
+
+	date, err := p.makeDate(today.Year(), int(month), day)
+	if err != nil && !(month == time.February && day == 29) {
+		return time.Time{}, true, err
+	}
+	if err != nil || date.Before(today) {
+		// Roll over to the next year, looking further ahead for a leap day
+		for year := today.Year() + 1; year <= today.Year()+8; year++ {
+			if date, err = p.makeDate(year, int(month), day); err == nil {
+				break
+			}
+		}
+	}
+	return date, true, err
+}
+
+var numericDate = regexp.MustCompile(`^(\d{1,2})[/.](\d{1,2})[/.](\d{4})$`)
+
+// parseNumeric handles DD/MM/YYYY and MM/DD/YYYY, rejecting dates where both readings are valid
+func (p *Parser) parseNumeric(text string) (time.Time, bool, error) {
+	match := numericDate.FindStringSubmatch(text)
+	if match == nil {
+		return time.Time{}, false, nil
+	}
+
+	first, _ := strconv.Atoi(match[1])
+	second, _ := strconv.Atoi(match[2])
+	year, _ := strconv.Atoi(match[3])
+
+	monthFirst, monthErr := p.makeDate(year, first, second)
+	dayFirst, dayErr := p.makeDate(year, second, first)
+
+	switch {
+	case monthErr == nil && dayErr == nil && !monthFirst.Equal(dayFirst):
+		return time.Time{}, true, fmt.Errorf("date '%s' is ambiguous: it could be %s or %s; use YYYY-MM-DD",
+			text, monthFirst.Format(Layout), dayFirst.Format(Layout))
+	case monthErr == nil:
+		return monthFirst, true, nil
+	case dayErr == nil:
+		return dayFirst, true, nil
+	default:
+		return time.Time{}, true, fmt.Errorf("invalid date '%s'", text)
+	}
+}
+
+// makeDate builds a date, rejecting values time.Date would silently normalise such as February 30
+func (p *Parser) makeDate(year, month, day int) (time.Time, error) {
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, p.location)
+	if month < 1 || month > 12 || date.Day() != day || int(date.Month()) != month {
+		return time.Time{}, fmt.Errorf("invalid date: %04d-%02d-%02d does not exist", year, month, day)
+	}
+	return date, nil
+}
+
+// nextWeekday returns the first date on or after day that falls on weekday
+func nextWeekday(day time.Time, weekday time.Weekday) time.Time {
+	return day.AddDate(0, 0, (int(weekday)-int(day.Weekday())+7)%7)
+}
+
+// startOfWeek returns the Monday of day's week
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func firstOfMonth(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+}
+
+// addMonthsClamped adds months, clamping to the last day of shorter months (Jan 31 + 1 month = Feb 28)
+func addMonthsClamped(day time.Time, months int) time.Time {
+	first := firstOfMonth(day).AddDate(0, months, 0)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day.Day(), last)-1)
+}
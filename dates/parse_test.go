@@ -0,0 +1,134 @@
+package dates
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Friday 16 October 2026, mid-morning UTC
+var fixedNow = time.Date(2026, time.October, 16, 10, 30, 0, 0, time.UTC)
+
+func newTestParser() *Parser {
+	return NewParser(time.UTC).WithClock(func() time.Time { return fixedNow })
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2026-10-20", "2026-10-20"},
+		{"2026-1-5", "2026-01-05"},
+		{" 2026-10-20T23:30:00Z ", "2026-10-20"},
+		{"today", "2026-10-16"},
+		{"Tomorrow", "2026-10-17"},
+		{"yesterday", "2026-10-15"},
+		{"friday", "2026-10-16"},
+		{"this friday", "2026-10-16"},
+		{"next friday", "2026-10-23"},
+		{"last friday", "2026-10-09"},
+		{"monday", "2026-10-19"},
+		{"next monday", "2026-10-19"},
+		{"next wed", "2026-10-21"},
+		{"on thursday", "2026-10-22"},
+		{"in 3 days", "2026-10-19"},
+		{"in a week", "2026-10-23"},
+		{"in 2 weeks", "2026-10-30"},
+		{"in 1 month", "2026-11-16"},
+		{"in a year", "2027-10-16"},
+		{"3 days from now", "2026-10-19"},
+		{"2 days ago", "2026-10-14"},
+		{"next week", "2026-10-19"},
+		{"next month", "2026-11-01"},
+		{"next year", "2027-01-01"},
+		{"end of week", "2026-10-18"},
+		{"end of month", "2026-10-31"},
+		{"by the end of next month", "2026-11-30"},
+		{"start of next week", "2026-10-19"},
+		{"beginning of next year", "2027-01-01"},
+		{"end of year", "2026-12-31"},
+		{"oct 20", "2026-10-20"},
+		{"October 20th", "2026-10-20"},
+		{"20 Oct", "2026-10-20"},
+		{"March 3", "2027-03-03"},
+		{"Sept 1, 2027", "2027-09-01"},
+		{"1st december 2026", "2026-12-01"},
+		{"feb 29", "2028-02-29"},
+		{"25/12/2026", "2026-12-25"},
+		{"12/25/2026", "2026-12-25"},
+		{"04/04/2026", "2026-04-04"},
+	}
+
+	parser := newTestParser()
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parser.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input         string
+		errorContains string
+	}{
+		{"", "date cannot be empty"},
+		{"someday", "could not understand date 'someday'"},
+		{"next fortnight", "could not understand date"},
+		{"03/04/2026", "ambiguous: it could be 2026-03-04 or 2026-04-03"},
+		{"2026-02-30", "2026-02-30 does not exist"},
+		{"2026-13-01", "does not exist"},
+		{"feb 30", "does not exist"},
+		{"31/31/2026", "invalid date '31/31/2026'"},
+		{"2026-10-20T25:00", "invalid timestamp"},
+		{"in 99999 days", "too large"},
+	}
+
+	parser := newTestParser()
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := parser.Parse(tt.input)
+			if err == nil {
+				t.Fatalf("Expected error containing %q, got nil", tt.errorContains)
+			}
+			if !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("Expected error containing %q, got %q", tt.errorContains, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseUsesConfiguredTimezone(t *testing.T) {
+	// 02:00 UTC on Saturday is still Friday evening five hours west
+	now := time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC)
+	west := time.FixedZone("UTC-5", -5*60*60)
+
+	utc := NewParser(time.UTC).WithClock(func() time.Time { return now })
+	local := NewParser(west).WithClock(func() time.Time { return now })
+
+	if got, _ := utc.Parse("today"); got != "2026-10-17" {
+		t.Errorf("Expected UTC today to be 2026-10-17, got %s", got)
+	}
+	if got, _ := local.Parse("today"); got != "2026-10-16" {
+		t.Errorf("Expected UTC-5 today to be 2026-10-16, got %s", got)
+	}
+	if got, _ := local.Parse("2026-10-17T02:00:00Z"); got != "2026-10-16" {
+		t.Errorf("Expected timestamp to be converted to UTC-5, got %s", got)
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	if day, err := ParseWeekday(" Thurs "); err != nil || day != time.Thursday {
+		t.Errorf("Expected Thursday, got %v (%v)", day, err)
+	}
+	if _, err := ParseWeekday("funday"); err == nil {
+		t.Error("Expected error for unknown weekday")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"tudidi_mcp/auth"
 	"tudidi_mcp/config"
+	"tudidi_mcp/dates"
 	"tudidi_mcp/tools"
 	"tudidi_mcp/tudidi"
 
@@ -35,9 +36,26 @@ func main() {
 	// Create Tudidi API instance
 	api := tudidi.NewAPI(client, cfg.Readonly)
 
+	// Resolve relative dates such as "tomorrow" in the configured timezone
+	location, err := cfg.Location()
+	if err != nil {
+		log.Fatalf("Configuration error: %v", err)
+	}
+
+	handlers := tools.NewHandlers(api, dates.NewParser(location))
+
 	// Create MCP server
 	opts := &mcp.ServerOptions{
-		Instructions: "Tudidi MCP Server for task management",
+		Instructions:      "Tudidi MCP Server for task management",
+		CompletionHandler: handlers.Complete,
+	}
+
+	// Poll for changes to notify clients subscribed to resources
+	var watcher *tools.Watcher
+	if cfg.PollInterval > 0 {
+		watcher = tools.NewWatcher(api, cfg.PollInterval)
+		opts.SubscribeHandler = watcher.Subscribe
+		opts.UnsubscribeHandler = watcher.Unsubscribe
 	}
 
 	server := mcp.NewServer(&mcp.Implementation{
@@ -45,9 +63,14 @@ func main() {
 		Version: "1.0.0",
 	}, opts)
 
-	// Register tools
-	handlers := tools.NewHandlers(api)
+	// Register tools, resources and prompts
 	handlers.RegisterTools(server)
+	handlers.RegisterResources(server)
+	handlers.RegisterPrompts(server)
+
+	if watcher != nil {
+		go watcher.Run(context.Background(), server)
+	}
 
 	// Log server status
 	readonlyStatus := ""
@@ -58,7 +81,7 @@ func main() {
 
 	// Run based on transport type
 	switch cfg.Transport {
-	case "stdio":
+	case config.TransportStdio:
 		transport := &mcp.LoggingTransport{
 			Transport: &mcp.StdioTransport{},
 			Writer:    os.Stderr,
@@ -66,7 +89,7 @@ func main() {
 		if err := server.Run(context.Background(), transport); err != nil {
 			log.Fatalf("Server failed: %v", err)
 		}
-	case "sse":
+	case config.TransportSSE:
 		// Create SSE handler
 		handler := mcp.NewSSEHandler(func(req *http.Request) *mcp.Server {
 			return server
@@ -77,6 +100,26 @@ func main() {
 		if err := http.ListenAndServe(addr, handler); err != nil {
 			log.Fatalf("SSE server failed: %v", err)
 		}
+	case config.TransportStreamable:
+		getServer := func(req *http.Request) *mcp.Server {
+			return server
+		}
+
+		// Sessions are stateful, so clients can resume a dropped stream with Last-Event-ID
+		mux := http.NewServeMux()
+		mux.Handle(cfg.BasePath, mcp.NewStreamableHTTPHandler(getServer, nil))
+		if cfg.LegacySSE {
+			mux.Handle(cfg.SSEPath(), mcp.NewSSEHandler(getServer))
+		}
+
+		addr := fmt.Sprintf(":%d", cfg.Port)
+		log.Printf("Starting streamable HTTP server on %s%s", addr, cfg.BasePath)
+		if cfg.LegacySSE {
+			log.Printf("Serving legacy SSE endpoint on %s%s", addr, cfg.SSEPath())
+		}
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Fatalf("Streamable HTTP server failed: %v", err)
+		}
 	default:
 		log.Fatalf("Unsupported transport: %s", cfg.Transport)
 	}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type AreaIDArgs struct {
+	ID int `json:"id" jsonschema:"Area ID"`
+}
+
+type CreateAreaArgs struct {
+	Name        string `json:"name" jsonschema:"Area name"`
+	Description string `json:"description,omitempty" jsonschema:"Area description"`
+}
+
+type UpdateAreaArgs struct {
+	ID          int    `json:"id" jsonschema:"Area ID"`
+	Name        string `json:"name,omitempty" jsonschema:"New area name"`
+	Description string `json:"description,omitempty" jsonschema:"New area description"`
+}
+
+type AreasResult struct {
+	Areas []tudidi.Area `json:"areas" jsonschema:"List of areas"`
+	Count int           `json:"count" jsonschema:"Number of areas"`
+}
+
+type AreaResult struct {
+	Area     tudidi.Area      `json:"area" jsonschema:"The area"`
+	Projects []tudidi.Project `json:"projects" jsonschema:"Projects belonging to the area"`
+}
+
+func (h *Handlers) listAreas(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *AreasResult, error) {
+	areas, err := h.api.GetAreas()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := AreasResult{
+		Areas: areas,
+		Count: len(areas),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatAreasText(areas)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) getArea(ctx context.Context, req *mcp.CallToolRequest, args AreaIDArgs) (*mcp.CallToolResult, *AreaResult, error) {
+	area, err := h.api.GetArea(args.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := AreaResult{Area: *area}
+	for _, project := range projects {
+		if project.AreaID == area.ID {
+			result.Projects = append(result.Projects, project)
+		}
+	}
+
+	prefix := fmt.Sprintf("Area: %s (%d projects)", area.Name, len(result.Projects))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatProjectsText(result.Projects, nil, prefix)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) createArea(ctx context.Context, req *mcp.CallToolRequest, args CreateAreaArgs) (*mcp.CallToolResult, *tudidi.Area, error) {
+	area, err := h.api.CreateArea(tudidi.AreaRequest{
+		Name:        args.Name,
+		Description: args.Description,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Created area: %s", area.Name)},
+		},
+	}, area, nil
+}
+
+func (h *Handlers) updateArea(ctx context.Context, req *mcp.CallToolRequest, args UpdateAreaArgs) (*mcp.CallToolResult, *tudidi.Area, error) {
+	area, err := h.api.UpdateArea(args.ID, tudidi.AreaRequest{
+		Name:        args.Name,
+		Description: args.Description,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Updated area: %s", area.Name)},
+		},
+	}, area, nil
+}
+
+func (h *Handlers) deleteArea(ctx context.Context, req *mcp.CallToolRequest, args AreaIDArgs) (*mcp.CallToolResult, any, error) {
+	if err := h.api.DeleteArea(args.ID); err != nil {
+		return nil, nil, err
+	}
+
+	result := map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Area %d deleted successfully", args.ID),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Deleted area %d", args.ID)},
+		},
+	}, result, nil
+}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"strconv"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxCompletions is the most values a completion response may hold
+const maxCompletions = 100
+
+// Complete is used as the server's CompletionHandler. It suggests project names
+// and tag names for prompt arguments, and project IDs and recently updated task
+// IDs for the {id} variable of the project and task resource templates.
+func (h *Handlers) Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	argument := req.Params.Argument
+
+	candidates, err := h.completionCandidates(req.Params.Ref, argument.Name)
+	if err != nil {
+		return nil, err
+	}
+	values := tudidi.Complete(argument.Value, candidates)
+
+	result := &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{Values: values, Total: len(values)},
+	}
+	if len(values) > maxCompletions {
+		result.Completion.Values = values[:maxCompletions]
+		result.Completion.HasMore = true
+	}
+	return result, nil
+}
+
+// completionCandidates picks what to suggest from the reference and argument name
+func (h *Handlers) completionCandidates(ref *mcp.CompleteReference, name string) ([]tudidi.CompletionCandidate, error) {
+	if ref != nil && ref.Type == "ref/resource" {
+		switch {
+		case ref.URI == taskURITemplate && name == "id":
+			return h.taskCandidates()
+		case ref.URI == projectURITemplate && name == "id":
+			return h.projectCandidates(true)
+		}
+		return nil, nil
+	}
+
+	// Prompt arguments; see RegisterPrompts
+	switch name {
+	case "project":
+		return h.projectCandidates(false)
+	case "tag":
+		return h.tagCandidates()
+	}
+	return nil, nil
+}
+
+// projectCandidates suggests projects by name, completing either the name or the ID
+func (h *Handlers) projectCandidates(byID bool) ([]tudidi.CompletionCandidate, error) {
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]tudidi.CompletionCandidate, 0, len(projects))
+	for _, project := range projects {
+		value := project.Name
+		if byID {
+			value = strconv.Itoa(project.ID)
+		}
+		candidates = append(candidates, tudidi.CompletionCandidate{Value: value, Text: project.Name})
+	}
+	return candidates, nil
+}
+
+func (h *Handlers) tagCandidates() ([]tudidi.CompletionCandidate, error) {
+	tags, err := h.api.GetTags()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]tudidi.CompletionCandidate, 0, len(tags))
+	for _, tag := range tags {
+		candidates = append(candidates, tudidi.CompletionCandidate{Value: tag.Name, Text: tag.Name})
+	}
+	return candidates, nil
+}
+
+// taskCandidates suggests the IDs of open tasks by name, most recently updated first
+func (h *Handlers) taskCandidates() ([]tudidi.CompletionCandidate, error) {
+	tasks, err := h.api.GetTasks(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := tudidi.RecentTasks(tasks)
+	candidates := make([]tudidi.CompletionCandidate, 0, len(recent))
+	for _, task := range recent {
+		candidates = append(candidates, tudidi.CompletionCandidate{Value: strconv.Itoa(task.ID), Text: task.Name})
+	}
+	return candidates, nil
+}
@@ -3,31 +3,359 @@ package tools
 import (
 	"fmt"
 	"strings"
+	"time"
 	"tudidi_mcp/tudidi"
 )
 
-// FormatProjectsText formats a slice of projects into readable text
-func FormatProjectsText(projects []tudidi.Project, prefix string) string {
+// FormatProjectsText formats a slice of projects into readable text.
+// When areas is non-empty, projects are grouped under their area name.
+func FormatProjectsText(projects []tudidi.Project, areas []tudidi.Area, prefix string) string {
 	var text strings.Builder
 	text.WriteString(fmt.Sprintf("%s:\n\n", prefix))
 
+	if len(areas) == 0 {
+		for _, project := range projects {
+			text.WriteString(formatSingleProject(project))
+			text.WriteString("---\n\n")
+		}
+		return text.String()
+	}
+
+	grouped := make(map[int][]tudidi.Project)
+	for _, project := range projects {
+		grouped[project.AreaID] = append(grouped[project.AreaID], project)
+	}
+
+	for _, area := range areas {
+		writeProjectGroup(&text, area.Name, grouped[area.ID])
+		delete(grouped, area.ID)
+	}
+
+	// Anything left has no area or points at an area we don't know about
+	var ungrouped []tudidi.Project
+	for _, project := range projects {
+		if _, ok := grouped[project.AreaID]; ok {
+			ungrouped = append(ungrouped, project)
+		}
+	}
+	writeProjectGroup(&text, "No Area", ungrouped)
+
+	return text.String()
+}
+
+// writeProjectGroup writes a titled group of projects, skipping empty groups
+func writeProjectGroup(text *strings.Builder, title string, projects []tudidi.Project) {
+	if len(projects) == 0 {
+		return
+	}
+
+	text.WriteString(fmt.Sprintf("## %s (%d)\n\n", title, len(projects)))
 	for _, project := range projects {
 		text.WriteString(formatSingleProject(project))
 		text.WriteString("---\n\n")
 	}
+}
+
+// FormatAreasText formats a slice of areas into readable text
+func FormatAreasText(areas []tudidi.Area) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Found %d areas:\n\n", len(areas)))
+
+	for _, area := range areas {
+		text.WriteString(fmt.Sprintf("ID: %d\n", area.ID))
+		text.WriteString(fmt.Sprintf("Name: %s\n", area.Name))
+		if area.Description != "" {
+			text.WriteString(fmt.Sprintf("Description: %s\n", area.Description))
+		}
+		text.WriteString("---\n\n")
+	}
 
 	return text.String()
 }
 
-// FormatTasksText formats a slice of tasks into readable text
+// FormatTasksText formats a slice of tasks into readable text.
+// Subtasks whose parent is also in the slice are indented under it.
 func FormatTasksText(tasks []tudidi.Task) string {
 	var text strings.Builder
 	text.WriteString(fmt.Sprintf("Found %d tasks:\n\n", len(tasks)))
 
+	present := make(map[int]bool, len(tasks))
+	for _, task := range tasks {
+		present[task.ID] = true
+	}
+
+	children := make(map[int][]tudidi.Task)
+	var roots []tudidi.Task
+	for _, task := range tasks {
+		if task.ParentTaskID != 0 && task.ParentTaskID != task.ID && present[task.ParentTaskID] {
+			children[task.ParentTaskID] = append(children[task.ParentTaskID], task)
+		} else {
+			roots = append(roots, task)
+		}
+	}
+
+	visited := make(map[int]bool, len(tasks))
+	for _, task := range roots {
+		writeTaskTree(&text, task, children, visited, 0)
+	}
+	// Tasks caught in a parent cycle have no root; print them flat so nothing is lost
+	for _, task := range tasks {
+		writeTaskTree(&text, task, children, visited, 0)
+	}
+
+	return text.String()
+}
+
+// FormatRankedTasksText formats tasks as a flat numbered list, keeping their order
+func FormatRankedTasksText(tasks []tudidi.Task, prefix string) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("%s:\n\n", prefix))
+
+	for i, task := range tasks {
+		text.WriteString(fmt.Sprintf("%d. ", i+1))
+		text.WriteString(formatSingleTask(task))
+		text.WriteString("---\n\n")
+	}
+
+	return text.String()
+}
+
+// FormatTodayText formats the Today view, grouped into overdue, due today and flagged tasks
+func FormatTodayText(view tudidi.TodayView) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Today (%s): %d tasks\n\n", view.Date, view.Count()))
+
+	writeTaskGroup(&text, 2, "Overdue", view.Overdue)
+	writeTaskGroup(&text, 2, "Due Today", view.DueToday)
+	writeTaskGroup(&text, 2, "On Today List", view.Flagged)
+
+	return text.String()
+}
+
+// FormatDayPlanText formats a proposed Today list with the reasons for each task
+func FormatDayPlanText(date string, plan []tudidi.PlannedTask, applied bool) string {
+	var text strings.Builder
+	if applied {
+		text.WriteString(fmt.Sprintf("Planned %d tasks for %s and added them to the Today list:\n\n", len(plan), date))
+	} else {
+		text.WriteString(fmt.Sprintf("Proposed %d tasks for %s (call again with apply=true to add them to the Today list):\n\n", len(plan), date))
+	}
+
+	for i, item := range plan {
+		text.WriteString(fmt.Sprintf("%d. [%d] %s\n", i+1, item.Task.ID, item.Task.Name))
+		text.WriteString(fmt.Sprintf("   Why: %s\n", strings.Join(item.Reasons, ", ")))
+	}
+
+	return text.String()
+}
+
+// FormatDueReportText formats tasks grouped by due date and project, preceded by per-project totals
+func FormatDueReportText(report *tudidi.DueReport, title string) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("%s:\n\n", title))
+
+	if len(report.Projects) > 0 {
+		text.WriteString("By project:\n")
+		for _, project := range report.Projects {
+			text.WriteString(fmt.Sprintf("  %s: %d\n", project.ProjectName, project.Count))
+		}
+		text.WriteString("\n")
+	}
+
+	for _, day := range report.Days {
+		text.WriteString(fmt.Sprintf("## %s (%d)\n\n", formatDayHeading(day.Date), day.Count))
+		for _, project := range day.Projects {
+			writeTaskGroup(&text, 3, project.ProjectName, project.Tasks)
+		}
+	}
+
+	return text.String()
+}
+
+// FormatWeeklyReviewText formats a weekly review as a short report, one line per task
+func FormatWeeklyReviewText(review tudidi.WeeklyReview) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Weekly review: %s to %s\n\n", formatDayHeading(review.WeekStart), formatDayHeading(review.WeekEnd)))
+
+	text.WriteString(fmt.Sprintf("## Completed This Week (%d)\n\n", review.CompletedCount))
+	for _, group := range review.Completed {
+		text.WriteString(fmt.Sprintf("%s (%d):\n", group.ProjectName, group.Count))
+		for _, task := range group.Tasks {
+			text.WriteString(fmt.Sprintf("  - [%d] %s\n", task.ID, task.Name))
+		}
+	}
+
+	text.WriteString(fmt.Sprintf("\n## New Tasks (%d)\n\n", review.CreatedCount))
+	for _, task := range review.Created {
+		text.WriteString(fmt.Sprintf("  - [%d] %s (added %s)\n", task.ID, task.Name, tudidi.DateOnly(task.CreatedAt)))
+	}
+
+	text.WriteString(fmt.Sprintf("\n## Slipping Due Dates (%d)\n\n", review.SlippingCount))
+	for _, task := range review.Slipping {
+		text.WriteString(fmt.Sprintf("  - [%d] %s (due %s)\n", task.ID, task.Name, tudidi.DateOnly(task.DueDate)))
+	}
+
+	text.WriteString(fmt.Sprintf("\n## Stale, Not Updated in %d+ Days (%d)\n\n", review.StaleDays, review.StaleCount))
+	for _, task := range review.Stale {
+		updated := task.UpdatedAt
+		if updated == "" {
+			updated = task.CreatedAt
+		}
+		text.WriteString(fmt.Sprintf("  - [%d] %s (last updated %s)\n", task.ID, task.Name, tudidi.DateOnly(updated)))
+	}
+
+	text.WriteString(fmt.Sprintf("\n## Projects Without a Next Action (%d)\n\n", len(review.ProjectsWithoutNextAction)))
+	for _, project := range review.ProjectsWithoutNextAction {
+		text.WriteString(fmt.Sprintf("  - [%d] %s\n", project.ID, project.Name))
+	}
+
+	return text.String()
+}
+
+// FormatTaskStatsText formats task statistics as a short report
+func FormatTaskStatsText(stats tudidi.TaskStats) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Task statistics from %s to %s:\n\n", stats.From, stats.To))
+
+	text.WriteString(fmt.Sprintf("Completed: %d tasks", stats.CompletedCount))
+	if days := len(stats.CompletedPerDay); days > 0 {
+		text.WriteString(fmt.Sprintf(" (%.1f per day)", float64(stats.CompletedCount)/float64(days)))
+	}
+	text.WriteString("\n")
+	if stats.CompletionSamples > 0 {
+		text.WriteString(fmt.Sprintf("Average time to complete: %s (from %d tasks)\n", formatHours(stats.AverageCompletionHours), stats.CompletionSamples))
+	}
+	text.WriteString(fmt.Sprintf("Current streak: %d days\n", stats.CurrentStreak))
+	text.WriteString(fmt.Sprintf("Longest streak: %d days\n", stats.LongestStreak))
+
+	text.WriteString("\n## Completed per Week\n\n")
+	for _, week := range stats.CompletedPerWeek {
+		text.WriteString(fmt.Sprintf("  Week of %s: %d\n", week.Date, week.Count))
+	}
+
+	text.WriteString(fmt.Sprintf("\n## Open Tasks (%d)\n\n", stats.OpenCount))
+	text.WriteString("By priority:\n")
+	for _, priority := range []tudidi.Priority{tudidi.PriorityHigh, tudidi.PriorityMedium, tudidi.PriorityLow} {
+		text.WriteString(fmt.Sprintf("  %s: %d\n", priority, stats.OpenByPriority[string(priority)]))
+	}
+	text.WriteString("By project:\n")
+	for _, project := range stats.OpenByProject {
+		text.WriteString(fmt.Sprintf("  %s: %d\n", project.ProjectName, project.Count))
+	}
+
+	return text.String()
+}
+
+// formatHours renders a duration in hours as hours or days, whichever reads better
+func formatHours(hours float64) string {
+	if hours < 48 {
+		return fmt.Sprintf("%.1f hours", hours)
+	}
+	return fmt.Sprintf("%.1f days", hours/24)
+}
+
+// formatDayHeading renders a YYYY-MM-DD date with its weekday, e.g. "Fri 2026-10-16"
+func formatDayHeading(date string) string {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return day.Format("Mon 2006-01-02")
+}
+
+// writeTaskGroup writes a group of tasks under a heading of the given level, skipping empty groups
+func writeTaskGroup(text *strings.Builder, level int, title string, tasks []tudidi.Task) {
+	if len(tasks) == 0 {
+		return
+	}
+
+	text.WriteString(fmt.Sprintf("%s %s (%d)\n\n", strings.Repeat("#", level), title, len(tasks)))
 	for _, task := range tasks {
 		text.WriteString(formatSingleTask(task))
 		text.WriteString("---\n\n")
 	}
+}
+
+// FormatTaskDetailsText formats a task followed by its direct subtasks
+func FormatTaskDetailsText(task tudidi.Task, subtasks []tudidi.Task) string {
+	var text strings.Builder
+	text.WriteString(formatSingleTask(task))
+
+	if len(subtasks) > 0 {
+		text.WriteString(fmt.Sprintf("\nSubtasks (%d):\n", len(subtasks)))
+		for _, subtask := range subtasks {
+			text.WriteString(fmt.Sprintf("  - [%d] %s\n", subtask.ID, subtask.Name))
+		}
+	}
+
+	return text.String()
+}
+
+// writeTaskTree writes a task and, recursively, its children with increasing indentation
+func writeTaskTree(text *strings.Builder, task tudidi.Task, children map[int][]tudidi.Task, visited map[int]bool, depth int) {
+	if visited[task.ID] {
+		return
+	}
+	visited[task.ID] = true
+
+	indent := strings.Repeat("    ", depth)
+	for _, line := range strings.Split(strings.TrimRight(formatSingleTask(task), "\n"), "\n") {
+		text.WriteString(indent + line + "\n")
+	}
+	text.WriteString(indent + "---\n\n")
+
+	for _, child := range children[task.ID] {
+		writeTaskTree(text, child, children, visited, depth+1)
+	}
+}
+
+// FormatTagsText formats a slice of tags into readable text
+func FormatTagsText(tags []tudidi.Tag) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Found %d tags:\n\n", len(tags)))
+
+	for _, tag := range tags {
+		text.WriteString(fmt.Sprintf("ID: %d\n", tag.ID))
+		text.WriteString(fmt.Sprintf("Name: %s\n", tag.Name))
+		text.WriteString("---\n\n")
+	}
+
+	return text.String()
+}
+
+// FormatNotesText formats a slice of notes into readable text
+func FormatNotesText(notes []tudidi.Note, prefix string) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("%s:\n\n", prefix))
+
+	for _, note := range notes {
+		text.WriteString(fmt.Sprintf("ID: %d\n", note.ID))
+		text.WriteString(fmt.Sprintf("Title: %s\n", note.Title))
+		if note.ProjectID != 0 {
+			text.WriteString(fmt.Sprintf("Project ID: %d\n", note.ProjectID))
+		}
+		if note.Content != "" {
+			text.WriteString(fmt.Sprintf("Content:\n%s\n", note.Content))
+		}
+		text.WriteString("---\n\n")
+	}
+
+	return text.String()
+}
+
+// FormatInboxText formats a slice of inbox items into readable text
+func FormatInboxText(items []tudidi.InboxItem) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Found %d inbox items:\n\n", len(items)))
+
+	for _, item := range items {
+		text.WriteString(fmt.Sprintf("ID: %d\n", item.ID))
+		text.WriteString(fmt.Sprintf("Content: %s\n", item.Content))
+		if item.CreatedAt != "" {
+			text.WriteString(fmt.Sprintf("Captured: %s\n", item.CreatedAt))
+		}
+		text.WriteString("---\n\n")
+	}
 
 	return text.String()
 }
@@ -44,6 +372,9 @@ func formatSingleProject(project tudidi.Project) string {
 		text.WriteString(fmt.Sprintf("Priority: %s\n", project.Priority))
 	}
 	text.WriteString(fmt.Sprintf("Active: %t\n", project.Active))
+	if project.PinToSidebar {
+		text.WriteString("Pinned: true\n")
+	}
 	if project.DueDateAt != "" {
 		text.WriteString(fmt.Sprintf("Due Date: %s\n", project.DueDateAt))
 	}
@@ -58,17 +389,131 @@ func formatSingleTask(task tudidi.Task) string {
 	if task.Note != "" {
 		text.WriteString(fmt.Sprintf("Note: %s\n", task.Note))
 	}
-	text.WriteString(fmt.Sprintf("Status: %d\n", task.Status))
-	text.WriteString(fmt.Sprintf("Priority: %d\n", task.Priority))
+	text.WriteString(fmt.Sprintf("Status: %s\n", formatStatus(task.Status)))
+	text.WriteString(fmt.Sprintf("Priority: %s\n", formatPriority(task.Priority)))
 	if task.DueDate != "" {
 		text.WriteString(fmt.Sprintf("Due Date: %s\n", task.DueDate))
 	}
 	if task.ProjectID != 0 {
 		text.WriteString(fmt.Sprintf("Project ID: %d\n", task.ProjectID))
 	}
+	if task.ParentTaskID != 0 {
+		text.WriteString(fmt.Sprintf("Parent Task ID: %d\n", task.ParentTaskID))
+	}
 	text.WriteString(fmt.Sprintf("Today: %t\n", task.Today))
+	if len(task.Tags) > 0 {
+		text.WriteString(fmt.Sprintf("Tags: %s\n", formatTagNames(task.Tags)))
+	}
+	if task.IsRecurring() {
+		text.WriteString(fmt.Sprintf("Repeats: %s\n", formatRecurrence(task.Recurrence)))
+	}
 	if task.CompletedAt != "" {
 		text.WriteString(fmt.Sprintf("Completed: %s\n", task.CompletedAt))
 	}
 	return text.String()
 }
+
+// formatStatus renders a Tudidi status code by name, falling back to the raw code
+func formatStatus(code int) string {
+	status, err := tudidi.StatusFromCode(code)
+	if err != nil {
+		return fmt.Sprintf("%d", code)
+	}
+	return string(status)
+}
+
+// formatPriority renders a Tudidi task priority code by name, falling back to the raw code
+func formatPriority(code int) string {
+	priority, err := tudidi.PriorityFromCode(code)
+	if err != nil {
+		return fmt.Sprintf("%d", code)
+	}
+	return string(priority)
+}
+
+// formatRecurrence renders a recurrence as a short phrase such as "every 2 weeks on Mon"
+func formatRecurrence(r tudidi.Recurrence) string {
+	interval := r.RecurrenceInterval
+	if interval < 1 {
+		interval = 1
+	}
+
+	every := func(unit string) string {
+		if interval == 1 {
+			return "every " + unit
+		}
+		return fmt.Sprintf("every %d %ss", interval, unit)
+	}
+
+	var summary string
+	switch r.RecurrenceType {
+	case tudidi.RecurrenceDaily:
+		summary = every("day")
+	case tudidi.RecurrenceWeekly:
+		summary = every("week")
+		if r.RecurrenceWeekday != nil {
+			summary += " on " + formatWeekday(*r.RecurrenceWeekday)
+		}
+	case tudidi.RecurrenceMonthly:
+		summary = every("month")
+		if r.RecurrenceMonthDay != nil {
+			summary += fmt.Sprintf(" on day %d", *r.RecurrenceMonthDay)
+		}
+	case tudidi.RecurrenceMonthlyWeekday:
+		summary = every("month")
+		if r.RecurrenceWeekday != nil && r.RecurrenceWeekOfMonth != nil {
+			summary += fmt.Sprintf(" on the %s %s", formatOrdinal(*r.RecurrenceWeekOfMonth), formatWeekday(*r.RecurrenceWeekday))
+		}
+	case tudidi.RecurrenceMonthlyLastDay:
+		summary = every("month") + " on the last day"
+	default:
+		summary = string(r.RecurrenceType)
+	}
+
+	if r.RecurrenceEndDate != "" {
+		summary += " until " + tudidi.DateOnly(r.RecurrenceEndDate)
+	}
+	return summary
+}
+
+// formatWeekday turns Tudidi's 0 (Sunday) to 6 (Saturday) weekday numbers into short names
+func formatWeekday(day int) string {
+	if day < 0 || day > 6 {
+		return fmt.Sprintf("weekday %d", day)
+	}
+	return time.Weekday(day).String()[:3]
+}
+
+// formatOrdinal renders 1 as "1st", 2 as "2nd" and so on
+func formatOrdinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// formatTagNames joins tag names into a comma-separated list
+func formatTagNames(tags []tudidi.Tag) string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatPageFooter tells the reader how far through a paginated listing they are
+func formatPageFooter(count, total int, nextCursor string) string {
+	if nextCursor == "" {
+		return ""
+	}
+	return fmt.Sprintf("Showing %d of %d. More results available with cursor: %s\n", count, total, nextCursor)
+}
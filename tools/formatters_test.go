@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"testing"
+	"tudidi_mcp/tudidi"
+)
+
+func TestFormatRecurrence(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    tudidi.Recurrence
+		expected string
+	}{
+		{"Daily", tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceDaily, RecurrenceInterval: 1}, "every day"},
+		{"Missing interval counts as 1", tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceDaily}, "every day"},
+		{
+			"Every 2 weeks on Mon",
+			tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceWeekly, RecurrenceInterval: 2, RecurrenceWeekday: intPtr(1)},
+			"every 2 weeks on Mon",
+		},
+		{
+			"Monthly on a day",
+			tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthly, RecurrenceInterval: 1, RecurrenceMonthDay: intPtr(15)},
+			"every month on day 15",
+		},
+		{
+			"Monthly weekday and week of month",
+			tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthlyWeekday, RecurrenceInterval: 1, RecurrenceWeekday: intPtr(4), RecurrenceWeekOfMonth: intPtr(3)},
+			"every month on the 3rd Thu",
+		},
+		{
+			"Monthly weekday missing week of month",
+			tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthlyWeekday, RecurrenceInterval: 3, RecurrenceWeekday: intPtr(4)},
+			"every 3 months",
+		},
+		{"Last day of the month", tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthlyLastDay, RecurrenceInterval: 1}, "every month on the last day"},
+		{
+			"End date",
+			tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceWeekly, RecurrenceInterval: 1, RecurrenceEndDate: "2026-12-31T00:00:00.000Z"},
+			"every week until 2026-12-31",
+		},
+		{"Unknown type is shown as is", tudidi.Recurrence{RecurrenceType: "yearly"}, "yearly"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatRecurrence(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -3,28 +3,43 @@ package tools
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"tudidi_mcp/dates"
 	"tudidi_mcp/tudidi"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type Handlers struct {
-	api *tudidi.API
+	api   *tudidi.API
+	dates *dates.Parser
 }
 
-func NewHandlers(api *tudidi.API) *Handlers {
-	return &Handlers{api: api}
+// NewHandlers creates the tool handlers; dateParser resolves relative dates
+// such as "tomorrow" and defaults to the local timezone when nil
+func NewHandlers(api *tudidi.API, dateParser *dates.Parser) *Handlers {
+	if dateParser == nil {
+		dateParser = dates.NewParser(nil)
+	}
+	return &Handlers{api: api, dates: dateParser}
 }
 
 func (h *Handlers) RegisterTools(server *mcp.Server) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_tasks",
-		Description: "List all tasks",
+		Description: "List open tasks, optionally filtered by status, project, tag, priority, due date range or the Today list",
 	}, h.listTasks)
 
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search_tasks",
+		Description: "Search tasks by name, note and tags; every term must match and \"quoted phrases\" are matched whole. Results are ranked by relevance",
+	}, h.searchTasks)
+
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "get_task",
-		Description: "Get a specific task by ID",
+		Description: "Get a specific task by ID, including its subtasks",
 	}, h.getTask)
 
 	mcp.AddTool(server, &mcp.Tool{
@@ -34,7 +49,7 @@ func (h *Handlers) RegisterTools(server *mcp.Server) {
 
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "update_task",
-		Description: "Update an existing task",
+		Description: "Update an existing task; only the fields given are changed",
 	}, h.updateTask)
 
 	mcp.AddTool(server, &mcp.Tool{
@@ -42,6 +57,66 @@ func (h *Handlers) RegisterTools(server *mcp.Server) {
 		Description: "Delete a task",
 	}, h.deleteTask)
 
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "complete_task",
+		Description: "Mark a task as completed",
+	}, h.completeTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "reopen_task",
+		Description: "Reopen a completed task, moving it back to not started",
+	}, h.reopenTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "start_task",
+		Description: "Mark a task as in progress",
+	}, h.startTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "break_down_task",
+		Description: "Break a task down into multiple subtasks created under it",
+	}, h.breakDownTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_today",
+		Description: "Show what needs doing today: overdue tasks, tasks due today and tasks on the Today list, grouped",
+	}, h.getToday)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "add_to_today",
+		Description: "Add a task to the Today list",
+	}, h.addToToday)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "remove_from_today",
+		Description: "Remove a task from the Today list",
+	}, h.removeFromToday)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "plan_my_day",
+		Description: "Propose a Today list from overdue and upcoming due dates, tasks in progress and priorities; set apply to add the proposed tasks to the Today list",
+	}, h.planMyDay)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_overdue_tasks",
+		Description: "List open tasks whose due date has passed, grouped by day and project, with counts",
+	}, h.getOverdueTasks)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_upcoming_tasks",
+		Description: "List open tasks due from today through the next N days, grouped by day and project, with counts",
+	}, h.getUpcomingTasks)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "weekly_review",
+		Description: "Weekly review: tasks completed this week by project, new tasks, slipping due dates, stale tasks and projects with no next action",
+	}, h.weeklyReview)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_task_stats",
+		Description: "Productivity statistics for a period: completions per day and week, average time to complete, open tasks by priority and project, and completion streaks",
+	}, h.getTaskStats)
+
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_projects",
 		Description: "List all projects for the user",
@@ -51,6 +126,111 @@ func (h *Handlers) RegisterTools(server *mcp.Server) {
 		Name:        "Search projects by name",
 		Description: "Search for projects by their name",
 	}, h.searchProjectsByName)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_project",
+		Description: "Get a specific project by ID",
+	}, h.getProject)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "create_project",
+		Description: "Create a new project",
+	}, h.createProject)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "update_project",
+		Description: "Update a project: rename, archive/reactivate, pin to sidebar, change priority, due date or area",
+	}, h.updateProject)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "delete_project",
+		Description: "Delete a project",
+	}, h.deleteProject)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_tags",
+		Description: "List all tags",
+	}, h.listTags)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "create_tag",
+		Description: "Create a new tag",
+	}, h.createTag)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "tag_task",
+		Description: "Add a tag to a task, creating the tag if it does not exist",
+	}, h.tagTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "untag_task",
+		Description: "Remove a tag from a task",
+	}, h.untagTask)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_areas",
+		Description: "List all areas",
+	}, h.listAreas)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_area",
+		Description: "Get a specific area by ID along with its projects",
+	}, h.getArea)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "create_area",
+		Description: "Create a new area",
+	}, h.createArea)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "update_area",
+		Description: "Rename an area or change its description",
+	}, h.updateArea)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "delete_area",
+		Description: "Delete an area",
+	}, h.deleteArea)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_notes",
+		Description: "List notes, optionally only those attached to a project",
+	}, h.listNotes)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "create_note",
+		Description: "Create a new note, optionally attached to a project",
+	}, h.createNote)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "append_to_note",
+		Description: "Append text to the end of an existing note",
+	}, h.appendToNote)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search_notes",
+		Description: "Search notes by title and content",
+	}, h.searchNotes)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_inbox",
+		Description: "List unprocessed inbox items",
+	}, h.listInbox)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "capture_to_inbox",
+		Description: "Quickly capture a thought into the inbox for later processing",
+	}, h.captureToInbox)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "delete_inbox_item",
+		Description: "Delete an inbox item without processing it",
+	}, h.deleteInboxItem)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "process_inbox_item",
+		Description: "Convert an inbox item into a task (with project, priority, due date) or a note, and clear it from the inbox",
+	}, h.processInboxItem)
 }
 
 type TaskIDArgs struct {
@@ -58,59 +238,283 @@ type TaskIDArgs struct {
 }
 
 type CreateTaskArgs struct {
-	Title       string `json:"title" jsonschema:"Task title"`
-	Description string `json:"description,omitempty" jsonschema:"Task description"`
-	ProjectID   int    `json:"project_id,omitempty" jsonschema:"Project ID where the task will be created"`
+	Title        string          `json:"title" jsonschema:"Task title"`
+	Description  string          `json:"description,omitempty" jsonschema:"Task description"`
+	ProjectID    int             `json:"project_id,omitempty" jsonschema:"Project ID where the task will be created"`
+	Project      string          `json:"project,omitempty" jsonschema:"Project name, as an alternative to project_id; matched loosely"`
+	ParentTaskID int             `json:"parent_task_id,omitempty" jsonschema:"Create the task as a subtask of this task; inherits its project if project_id is not given"`
+	Priority     string          `json:"priority,omitempty" jsonschema:"Task priority: low, medium or high"`
+	DueDate      string          `json:"due_date,omitempty" jsonschema:"Task due date: YYYY-MM-DD or an expression such as tomorrow, next friday, in 3 days or end of month"`
+	Today        bool            `json:"today,omitempty" jsonschema:"Add the task to the Today list"`
+	Tags         []string        `json:"tags,omitempty" jsonschema:"Tag names to attach, e.g. @home"`
+	Status       string          `json:"status,omitempty" jsonschema:"Initial status: not_started (default), in_progress or waiting; completed and archived are rejected"`
+	Recurrence   *RecurrenceArgs `json:"recurrence,omitempty" jsonschema:"Make the task repeat"`
+}
+
+type RecurrenceArgs struct {
+	Type        string `json:"type" jsonschema:"Recurrence type: none, daily, weekly, monthly, monthly_weekday or monthly_last_day"`
+	Interval    int    `json:"interval,omitempty" jsonschema:"Repeat every N days/weeks/months (default 1)"`
+	EndDate     string `json:"end_date,omitempty" jsonschema:"Stop repeating after this date: YYYY-MM-DD or an expression such as end of year"`
+	Weekday     string `json:"weekday,omitempty" jsonschema:"Day of the week for weekly and monthly_weekday recurrence, e.g. mon"`
+	MonthDay    int    `json:"month_day,omitempty" jsonschema:"Day of the month (1-31) for monthly recurrence"`
+	WeekOfMonth int    `json:"week_of_month,omitempty" jsonschema:"Week of the month (1-5) for monthly_weekday recurrence"`
 }
 
 type UpdateTaskArgs struct {
-	ID          int    `json:"id" jsonschema:"Task ID"`
-	Title       string `json:"title,omitempty" jsonschema:"New task title"`
-	Description string `json:"description,omitempty" jsonschema:"New task description"`
-	Completed   *bool  `json:"completed,omitempty" jsonschema:"Task completion status"`
+	ID           int             `json:"id" jsonschema:"Task ID"`
+	Title        string          `json:"title,omitempty" jsonschema:"New task title"`
+	Description  *string         `json:"description,omitempty" jsonschema:"New task description; an empty string clears it"`
+	Completed    *bool           `json:"completed,omitempty" jsonschema:"true completes the task; false reopens it if it is completed or archived and leaves other statuses unchanged"`
+	Priority     string          `json:"priority,omitempty" jsonschema:"New priority: low, medium or high"`
+	DueDate      *string         `json:"due_date,omitempty" jsonschema:"New due date: YYYY-MM-DD or an expression such as next friday; an empty string clears it"`
+	Today        *bool           `json:"today,omitempty" jsonschema:"Add the task to (true) or remove it from (false) the Today list"`
+	ProjectID    int             `json:"project_id,omitempty" jsonschema:"Move the task to this project"`
+	Project      string          `json:"project,omitempty" jsonschema:"Name of the project to move the task to, as an alternative to project_id; matched loosely"`
+	ClearProject bool            `json:"clear_project,omitempty" jsonschema:"Take the task out of its project; cannot be combined with project_id or project"`
+	ParentTaskID int             `json:"parent_task_id,omitempty" jsonschema:"Make the task a subtask of this task"`
+	ClearParent  bool            `json:"clear_parent,omitempty" jsonschema:"Detach the task from its parent task; cannot be combined with parent_task_id"`
+	Recurrence   *RecurrenceArgs `json:"recurrence,omitempty" jsonschema:"Change how the task repeats; use type none to stop repeating"`
 }
 
 type TasksResult struct {
-	Tasks []tudidi.Task `json:"task" jsonschema:"List of tasks"`
-	Count int           `json:"count" jsonschema:"Number of tasks"`
+	Tasks      []tudidi.Task `json:"task" jsonschema:"List of tasks"`
+	Count      int           `json:"count" jsonschema:"Number of tasks"`
+	Total      int           `json:"total" jsonschema:"Number of tasks across all pages"`
+	NextCursor string        `json:"next_cursor,omitempty" jsonschema:"Pass as cursor to fetch the next page; absent on the last page"`
+}
+
+type ListTasksArgs struct {
+	Status           string `json:"status,omitempty" jsonschema:"Only tasks with this status: not_started, in_progress, completed, archived, waiting"`
+	ProjectID        int    `json:"project_id,omitempty" jsonschema:"Only tasks in this project"`
+	Project          string `json:"project,omitempty" jsonschema:"Only tasks in the project with this name, as an alternative to project_id; matched loosely"`
+	Tag              string `json:"tag,omitempty" jsonschema:"Only tasks with this tag"`
+	Priority         string `json:"priority,omitempty" jsonschema:"Only tasks with this priority: low, medium or high"`
+	DueBefore        string `json:"due_before,omitempty" jsonschema:"Only tasks due on or before this date: YYYY-MM-DD or an expression such as end of week"`
+	DueAfter         string `json:"due_after,omitempty" jsonschema:"Only tasks due on or after this date: YYYY-MM-DD or an expression such as today"`
+	Today            bool   `json:"today,omitempty" jsonschema:"Only tasks on the Today list"`
+	IncludeCompleted bool   `json:"include_completed,omitempty" jsonschema:"Also include completed and archived tasks"`
+	SortBy           string `json:"sort_by,omitempty" jsonschema:"Sort key: due_date, priority, created, updated, name or project (by project name); defaults to the project's own sort order when project_id is given"`
+	SortOrder        string `json:"sort_order,omitempty" jsonschema:"Sort direction: asc (default) or desc"`
+	Limit            int    `json:"limit,omitempty" jsonschema:"Maximum number of tasks to return (default 50, max 200)"`
+	Cursor           string `json:"cursor,omitempty" jsonschema:"next_cursor from a previous call, to fetch the following page"`
+}
+
+type SearchTasksArgs struct {
+	Query            string `json:"query" jsonschema:"Search terms; wrap phrases in double quotes"`
+	IncludeCompleted bool   `json:"include_completed,omitempty" jsonschema:"Also include completed and archived tasks"`
+	Limit            int    `json:"limit,omitempty" jsonschema:"Maximum number of tasks to return (default 50, max 200)"`
+}
+
+// taskFilter converts list arguments into a validated tudidi.TaskFilter
+func (args ListTasksArgs) taskFilter(parser *dates.Parser) (*tudidi.TaskFilter, error) {
+	filter := tudidi.TaskFilter{
+		ProjectID:        args.ProjectID,
+		Tag:              args.Tag,
+		TodayOnly:        args.Today,
+		IncludeCompleted: args.IncludeCompleted,
+	}
+
+	if args.Status != "" {
+		status := tudidi.Status(strings.ToLower(strings.TrimSpace(args.Status)))
+		if _, err := status.Code(); err != nil {
+			return nil, err
+		}
+		filter.Status = &status
+	}
+
+	var err error
+	if filter.Priority, err = parsePriorityArg(args.Priority); err != nil {
+		return nil, err
+	}
+	if filter.DueBefore, err = parseDateArg(parser, args.DueBefore); err != nil {
+		return nil, err
+	}
+	if filter.DueAfter, err = parseDateArg(parser, args.DueAfter); err != nil {
+		return nil, err
+	}
+
+	if args.SortBy != "" {
+		if filter.Sort, err = tudidi.ParseTaskSort(args.SortBy + ":" + args.SortOrder); err != nil {
+			return nil, err
+		}
+	} else if args.SortOrder != "" {
+		return nil, fmt.Errorf("sort_order requires sort_by")
+	}
+
+	return &filter, nil
 }
 
-func (h *Handlers) listTasks(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *TasksResult, error) {
-	tasks, err := h.api.GetTasks()
+// resolveProjectArg returns the project ID from either a project_id or a project name argument
+func (h *Handlers) resolveProjectArg(projectID int, name string) (int, error) {
+	if strings.TrimSpace(name) == "" {
+		return projectID, nil
+	}
+	if projectID != 0 {
+		return 0, fmt.Errorf("give either project_id or project, not both")
+	}
+
+	project, err := h.api.ResolveProject(name)
+	if err != nil {
+		return 0, err
+	}
+	return project.ID, nil
+}
+
+func (h *Handlers) listTasks(ctx context.Context, req *mcp.CallToolRequest, args ListTasksArgs) (*mcp.CallToolResult, *TasksResult, error) {
+	filter, err := args.taskFilter(h.dates)
+	if err != nil {
+		return nil, nil, err
+	}
+	if filter.ProjectID, err = h.resolveProjectArg(args.ProjectID, args.Project); err != nil {
+		return nil, nil, err
+	}
+
+	tasks, err := h.api.GetTasks(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page, next, err := paginate(tasks, func(t tudidi.Task) int { return t.ID }, args.Limit, args.Cursor)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	result := TasksResult{
-		Tasks: tasks,
-		Count: len(tasks),
+		Tasks:      page,
+		Count:      len(page),
+		Total:      len(tasks),
+		NextCursor: next,
 	}
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: FormatTasksText(tasks)},
+			&mcp.TextContent{Text: FormatTasksText(page) + formatPageFooter(len(page), len(tasks), next)},
 		},
 	}, &result, nil
 }
 
-func (h *Handlers) getTask(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+// TaskDetails is a task together with its direct subtasks
+type TaskDetails struct {
+	tudidi.Task
+	Subtasks []tudidi.Task `json:"subtasks" jsonschema:"Direct subtasks of the task"`
+}
+
+func (h *Handlers) searchTasks(ctx context.Context, req *mcp.CallToolRequest, args SearchTasksArgs) (*mcp.CallToolResult, *TasksResult, error) {
+	if args.Limit < 0 {
+		return nil, nil, fmt.Errorf("limit cannot be negative")
+	}
+
+	matches, err := h.api.SearchTasks(args.Query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []tudidi.Task
+	for _, task := range matches {
+		if args.IncludeCompleted || !task.IsClosed() {
+			tasks = append(tasks, task)
+		}
+	}
+
+	limit := min(args.Limit, maxPageSize)
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	top := tasks[:min(limit, len(tasks))]
+
+	result := TasksResult{
+		Tasks: top,
+		Count: len(top),
+		Total: len(tasks),
+	}
+
+	prefix := fmt.Sprintf("Found %d tasks matching '%s'", len(tasks), args.Query)
+	if len(top) < len(tasks) {
+		prefix += fmt.Sprintf(", showing the top %d", len(top))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatRankedTasksText(top, prefix)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) getTask(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *TaskDetails, error) {
 	task, err := h.api.GetTask(args.ID)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// The task itself is still worth returning when its subtasks can't be listed
+	text := ""
+	subtasks, err := h.api.GetSubtasks(task.ID)
+	if err != nil {
+		log.Printf("Failed to get subtasks of task %d: %v", task.ID, err)
+		subtasks = []tudidi.Task{}
+		text = "\nSubtasks could not be loaded.\n"
+	}
+
+	result := TaskDetails{
+		Task:     *task,
+		Subtasks: subtasks,
+	}
+
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: fmt.Sprintf("Task: %s", task.Name)},
+			&mcp.TextContent{Text: FormatTaskDetailsText(*task, subtasks) + text},
 		},
-	}, task, nil
+	}, &result, nil
 }
 
 func (h *Handlers) createTask(ctx context.Context, req *mcp.CallToolRequest, args CreateTaskArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	priority, err := parsePriorityArg(args.Priority)
+	if err != nil {
+		return nil, nil, err
+	}
+	dueDate, err := parseDateArg(h.dates, args.DueDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	status, err := parseStatusArg(args.Status)
+	if err != nil {
+		return nil, nil, err
+	}
+	tags, err := parseTagsArg(args.Tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectID, err := h.resolveProjectArg(args.ProjectID, args.Project)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	createReq := tudidi.CreateTaskRequest{
-		Name:      args.Title,
-		Note:      args.Description,
-		ProjectID: args.ProjectID,
+		Name:         args.Title,
+		Note:         args.Description,
+		ProjectID:    projectID,
+		Status:       status,
+		Priority:     priority,
+		DueDate:      dueDate,
+		Today:        args.Today,
+		Tags:         tags,
+		ParentTaskID: args.ParentTaskID,
+	}
+
+	recurrence, err := parseRecurrenceArg(h.dates, args.Recurrence)
+	if err != nil {
+		return nil, nil, err
+	}
+	if recurrence != nil {
+		createReq.Recurrence = *recurrence
+	}
+
+	if createReq.ParentTaskID != 0 && createReq.ProjectID == 0 {
+		parent, err := h.api.GetTask(createReq.ParentTaskID)
+		if err != nil {
+			return nil, nil, err
+		}
+		createReq.ProjectID = parent.ProjectID
 	}
 
 	task, err := h.api.CreateTask(createReq)
@@ -126,9 +530,59 @@ func (h *Handlers) createTask(ctx context.Context, req *mcp.CallToolRequest, arg
 }
 
 func (h *Handlers) updateTask(ctx context.Context, req *mcp.CallToolRequest, args UpdateTaskArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	recurrence, err := parseRecurrenceArg(h.dates, args.Recurrence)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	updateReq := tudidi.UpdateTaskRequest{
-		Name: args.Title,
-		Note: args.Description,
+		Note:       args.Description,
+		Today:      args.Today,
+		Recurrence: recurrence,
+	}
+
+	if args.Title != "" {
+		updateReq.Name = &args.Title
+	}
+	if args.Priority != "" {
+		priority, err := parsePriorityArg(args.Priority)
+		if err != nil {
+			return nil, nil, err
+		}
+		updateReq.Priority = &priority
+	}
+	if args.DueDate != nil {
+		dueDate, err := parseDateArg(h.dates, *args.DueDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		updateReq.DueDate = &dueDate
+	}
+	projectID, err := h.resolveProjectArg(args.ProjectID, args.Project)
+	if err != nil {
+		return nil, nil, err
+	}
+	if args.ClearProject && projectID != 0 {
+		return nil, nil, fmt.Errorf("clear_project cannot be combined with project_id or project")
+	}
+	if args.ClearParent && args.ParentTaskID != 0 {
+		return nil, nil, fmt.Errorf("clear_parent cannot be combined with parent_task_id")
+	}
+	// A zero ID clears the project or parent; see tudidi.UpdateTaskRequest
+	if projectID != 0 || args.ClearProject {
+		updateReq.ProjectID = &projectID
+	}
+	if args.ParentTaskID != 0 || args.ClearParent {
+		updateReq.ParentTaskID = &args.ParentTaskID
+	}
+
+	if args.Completed != nil {
+		if *args.Completed {
+			status := tudidi.Completed
+			updateReq.Status = &status
+		} else {
+			updateReq.Reopen = true
+		}
 	}
 
 	task, err := h.api.UpdateTask(args.ID, updateReq)
@@ -161,27 +615,102 @@ func (h *Handlers) deleteTask(ctx context.Context, req *mcp.CallToolRequest, arg
 	}, result, nil
 }
 
+func (h *Handlers) completeTask(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	return h.setTaskStatus(args.ID, tudidi.Completed, "Completed")
+}
+
+func (h *Handlers) reopenTask(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	return h.setTaskStatus(args.ID, tudidi.NotStarted, "Reopened")
+}
+
+func (h *Handlers) startTask(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	return h.setTaskStatus(args.ID, tudidi.InProgress, "Started")
+}
+
+// setTaskStatus backs the status transition tools; verb describes the transition in the reply
+func (h *Handlers) setTaskStatus(id int, status tudidi.Status, verb string) (*mcp.CallToolResult, *tudidi.Task, error) {
+	task, err := h.api.SetTaskStatus(id, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%s task: %s", verb, task.Name)},
+		},
+	}, task, nil
+}
+
+type BreakDownTaskArgs struct {
+	ParentTaskID int      `json:"parent_task_id" jsonschema:"ID of the task to break down"`
+	Subtasks     []string `json:"subtasks" jsonschema:"Names of the subtasks to create, in order"`
+}
+
+func (h *Handlers) breakDownTask(ctx context.Context, req *mcp.CallToolRequest, args BreakDownTaskArgs) (*mcp.CallToolResult, *TasksResult, error) {
+	subtasks, err := h.api.CreateSubtasks(args.ParentTaskID, args.Subtasks)
+	if err != nil {
+		if len(subtasks) > 0 {
+			return nil, nil, fmt.Errorf("created %d of %d subtasks before failing: %w", len(subtasks), len(args.Subtasks), err)
+		}
+		return nil, nil, err
+	}
+
+	result := TasksResult{
+		Tasks: subtasks,
+		Count: len(subtasks),
+		Total: len(subtasks),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Created %d subtasks under task %d", len(subtasks), args.ParentTaskID)},
+		},
+	}, &result, nil
+}
+
 type ProjectsResult struct {
-	Projects []tudidi.Project `json:"projects" jsonschema:"List of projects"`
-	Count    int              `json:"count" jsonschema:"Number of projects"`
+	Projects   []tudidi.Project `json:"projects" jsonschema:"List of projects"`
+	Count      int              `json:"count" jsonschema:"Number of projects"`
+	Total      int              `json:"total" jsonschema:"Number of projects across all pages"`
+	NextCursor string           `json:"next_cursor,omitempty" jsonschema:"Pass as cursor to fetch the next page; absent on the last page"`
 }
 
-func (h *Handlers) listProjects(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *ProjectsResult, error) {
-	projects, err := h.api.GetProjects()
+type ListProjectsArgs struct {
+	Limit  int    `json:"limit,omitempty" jsonschema:"Maximum number of projects to return (default 50, max 200)"`
+	Cursor string `json:"cursor,omitempty" jsonschema:"next_cursor from a previous call, to fetch the following page"`
+}
+
+func (h *Handlers) listProjects(ctx context.Context, req *mcp.CallToolRequest, args ListProjectsArgs) (*mcp.CallToolResult, *ProjectsResult, error) {
+	allProjects, err := h.api.GetProjects()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	sort.SliceStable(allProjects, func(i, j int) bool { return allProjects[i].ID < allProjects[j].ID })
+
+	projects, next, err := paginate(allProjects, func(p tudidi.Project) int { return p.ID }, args.Limit, args.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Areas only drive grouping of the text output, so fall back to a flat list if they can't be fetched
+	areas, err := h.api.GetAreas()
+	if err != nil {
+		areas = nil
+	}
+
 	result := ProjectsResult{
-		Projects: projects,
-		Count:    len(projects),
+		Projects:   projects,
+		Count:      len(projects),
+		Total:      len(allProjects),
+		NextCursor: next,
 	}
 
 	prefix := fmt.Sprintf("Found %d projects", len(projects))
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: FormatProjectsText(projects, prefix)},
+			&mcp.TextContent{Text: FormatProjectsText(projects, areas, prefix) + formatPageFooter(len(projects), len(allProjects), next)},
 		},
 	}, &result, nil
 }
@@ -199,13 +728,114 @@ func (h *Handlers) searchProjectsByName(ctx context.Context, req *mcp.CallToolRe
 	result := ProjectsResult{
 		Projects: projects,
 		Count:    len(projects),
+		Total:    len(projects),
 	}
 
 	prefix := fmt.Sprintf("Found %d projects matching '%s'", len(projects), args.Name)
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: FormatProjectsText(projects, prefix)},
+			&mcp.TextContent{Text: FormatProjectsText(projects, nil, prefix)},
 		},
 	}, &result, nil
 }
+
+type ProjectIDArgs struct {
+	ID int `json:"id" jsonschema:"Project ID"`
+}
+
+type CreateProjectArgs struct {
+	Name         string `json:"name" jsonschema:"Project name"`
+	Description  string `json:"description,omitempty" jsonschema:"Project description"`
+	Priority     string `json:"priority,omitempty" jsonschema:"Project priority: low, medium or high"`
+	DueDate      string `json:"due_date,omitempty" jsonschema:"Project due date (YYYY-MM-DD)"`
+	AreaID       int    `json:"area_id,omitempty" jsonschema:"Area ID the project belongs to"`
+	PinToSidebar bool   `json:"pin_to_sidebar,omitempty" jsonschema:"Pin the project to the sidebar"`
+}
+
+type UpdateProjectArgs struct {
+	ID           int    `json:"id" jsonschema:"Project ID"`
+	Name         string `json:"name,omitempty" jsonschema:"New project name"`
+	Description  string `json:"description,omitempty" jsonschema:"New project description"`
+	Active       *bool  `json:"active,omitempty" jsonschema:"Set to false to archive the project, true to reactivate it"`
+	PinToSidebar *bool  `json:"pin_to_sidebar,omitempty" jsonschema:"Pin or unpin the project in the sidebar"`
+	Priority     string `json:"priority,omitempty" jsonschema:"New priority: low, medium or high"`
+	DueDate      string `json:"due_date,omitempty" jsonschema:"New due date (YYYY-MM-DD)"`
+	AreaID       *int   `json:"area_id,omitempty" jsonschema:"Move the project to this area"`
+}
+
+func (h *Handlers) getProject(ctx context.Context, req *mcp.CallToolRequest, args ProjectIDArgs) (*mcp.CallToolResult, *tudidi.Project, error) {
+	project, err := h.api.GetProject(args.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: formatSingleProject(*project)},
+		},
+	}, project, nil
+}
+
+func (h *Handlers) createProject(ctx context.Context, req *mcp.CallToolRequest, args CreateProjectArgs) (*mcp.CallToolResult, *tudidi.Project, error) {
+	createReq := tudidi.CreateProjectRequest{
+		Name:         args.Name,
+		Description:  args.Description,
+		Active:       true,
+		PinToSidebar: args.PinToSidebar,
+		Priority:     tudidi.Priority(args.Priority),
+		DueDateAt:    args.DueDate,
+		AreaID:       args.AreaID,
+	}
+
+	project, err := h.api.CreateProject(createReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Created project: %s", project.Name)},
+		},
+	}, project, nil
+}
+
+func (h *Handlers) updateProject(ctx context.Context, req *mcp.CallToolRequest, args UpdateProjectArgs) (*mcp.CallToolResult, *tudidi.Project, error) {
+	updateReq := tudidi.UpdateProjectRequest{
+		Name:         args.Name,
+		Description:  args.Description,
+		Active:       args.Active,
+		PinToSidebar: args.PinToSidebar,
+		Priority:     tudidi.Priority(args.Priority),
+		DueDateAt:    args.DueDate,
+		AreaID:       args.AreaID,
+	}
+
+	project, err := h.api.UpdateProject(args.ID, updateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Updated project: %s", project.Name)},
+		},
+	}, project, nil
+}
+
+func (h *Handlers) deleteProject(ctx context.Context, req *mcp.CallToolRequest, args ProjectIDArgs) (*mcp.CallToolResult, any, error) {
+	if err := h.api.DeleteProject(args.ID); err != nil {
+		return nil, nil, err
+	}
+
+	result := map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Project %d deleted successfully", args.ID),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Deleted project %d", args.ID)},
+		},
+	}, result, nil
+}
@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type InboxItemIDArgs struct {
+	ID int `json:"id" jsonschema:"Inbox item ID"`
+}
+
+type CaptureToInboxArgs struct {
+	Content string `json:"content" jsonschema:"Text to capture into the inbox"`
+}
+
+type ProcessInboxItemArgs struct {
+	ID        int    `json:"id" jsonschema:"Inbox item ID"`
+	As        string `json:"as,omitempty" jsonschema:"What to turn the item into: task (default) or note"`
+	Title     string `json:"title,omitempty" jsonschema:"Task name or note title; defaults to the inbox item content"`
+	Note      string `json:"note,omitempty" jsonschema:"Task note or note content"`
+	ProjectID int    `json:"project_id,omitempty" jsonschema:"Project ID to file the task or note under"`
+	Project   string `json:"project,omitempty" jsonschema:"Project name to file the task or note under, as an alternative to project_id; matched loosely"`
+	Priority  string `json:"priority,omitempty" jsonschema:"Task priority: low, medium or high (tasks only)"`
+	DueDate   string `json:"due_date,omitempty" jsonschema:"Task due date, tasks only: YYYY-MM-DD or an expression such as tomorrow"`
+}
+
+type InboxResult struct {
+	Items []tudidi.InboxItem `json:"items" jsonschema:"List of inbox items"`
+	Count int                `json:"count" jsonschema:"Number of inbox items"`
+}
+
+type ProcessInboxResult struct {
+	Task *tudidi.Task `json:"task,omitempty" jsonschema:"Task created from the inbox item"`
+	Note *tudidi.Note `json:"note,omitempty" jsonschema:"Note created from the inbox item"`
+}
+
+func (h *Handlers) listInbox(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *InboxResult, error) {
+	items, err := h.api.GetInboxItems()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := InboxResult{
+		Items: items,
+		Count: len(items),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatInboxText(items)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) captureToInbox(ctx context.Context, req *mcp.CallToolRequest, args CaptureToInboxArgs) (*mcp.CallToolResult, *tudidi.InboxItem, error) {
+	item, err := h.api.CreateInboxItem(args.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Captured to inbox: %s", item.Content)},
+		},
+	}, item, nil
+}
+
+func (h *Handlers) deleteInboxItem(ctx context.Context, req *mcp.CallToolRequest, args InboxItemIDArgs) (*mcp.CallToolResult, any, error) {
+	if err := h.api.DeleteInboxItem(args.ID); err != nil {
+		return nil, nil, err
+	}
+
+	result := map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Inbox item %d deleted successfully", args.ID),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Deleted inbox item %d", args.ID)},
+		},
+	}, result, nil
+}
+
+func (h *Handlers) processInboxItem(ctx context.Context, req *mcp.CallToolRequest, args ProcessInboxItemArgs) (*mcp.CallToolResult, *ProcessInboxResult, error) {
+	projectID, err := h.resolveProjectArg(args.ProjectID, args.Project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(args.As)) {
+	case "", "task":
+		priority, err := parsePriorityArg(args.Priority)
+		if err != nil {
+			return nil, nil, err
+		}
+		dueDate, err := parseDateArg(h.dates, args.DueDate)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		task, err := h.api.ProcessInboxItemAsTask(args.ID, tudidi.CreateTaskRequest{
+			Name:      args.Title,
+			Note:      args.Note,
+			ProjectID: projectID,
+			Status:    tudidi.NotStarted,
+			Priority:  priority,
+			DueDate:   dueDate,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Processed inbox item %d into task: %s", args.ID, task.Name)},
+			},
+		}, &ProcessInboxResult{Task: task}, nil
+	case "note":
+		if args.Priority != "" || args.DueDate != "" {
+			return nil, nil, fmt.Errorf("priority and due_date only apply when processing into a task")
+		}
+
+		note, err := h.api.ProcessInboxItemAsNote(args.ID, tudidi.CreateNoteRequest{
+			Title:     args.Title,
+			Content:   args.Note,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("Processed inbox item %d into note: %s", args.ID, note.Title)},
+			},
+		}, &ProcessInboxResult{Note: note}, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid value for 'as': %s (must be task or note)", args.As)
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ListNotesArgs struct {
+	ProjectID int `json:"project_id,omitempty" jsonschema:"Only list notes attached to this project"`
+}
+
+type CreateNoteArgs struct {
+	Title     string `json:"title" jsonschema:"Note title"`
+	Content   string `json:"content,omitempty" jsonschema:"Note content (markdown)"`
+	ProjectID int    `json:"project_id,omitempty" jsonschema:"Project ID to attach the note to"`
+}
+
+type AppendToNoteArgs struct {
+	ID   int    `json:"id" jsonschema:"Note ID"`
+	Text string `json:"text" jsonschema:"Text to append to the end of the note"`
+}
+
+type SearchNotesArgs struct {
+	Query string `json:"query" jsonschema:"Text to search for in note titles and content"`
+}
+
+type NotesResult struct {
+	Notes []tudidi.Note `json:"notes" jsonschema:"List of notes"`
+	Count int           `json:"count" jsonschema:"Number of notes"`
+}
+
+func (h *Handlers) listNotes(ctx context.Context, req *mcp.CallToolRequest, args ListNotesArgs) (*mcp.CallToolResult, *NotesResult, error) {
+	notes, err := h.api.GetNotes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if args.ProjectID != 0 {
+		var filtered []tudidi.Note
+		for _, note := range notes {
+			if note.ProjectID == args.ProjectID {
+				filtered = append(filtered, note)
+			}
+		}
+		notes = filtered
+	}
+
+	result := NotesResult{
+		Notes: notes,
+		Count: len(notes),
+	}
+
+	prefix := fmt.Sprintf("Found %d notes", len(notes))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatNotesText(notes, prefix)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) createNote(ctx context.Context, req *mcp.CallToolRequest, args CreateNoteArgs) (*mcp.CallToolResult, *tudidi.Note, error) {
+	note, err := h.api.CreateNote(tudidi.CreateNoteRequest{
+		Title:     args.Title,
+		Content:   args.Content,
+		ProjectID: args.ProjectID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Created note: %s", note.Title)},
+		},
+	}, note, nil
+}
+
+func (h *Handlers) appendToNote(ctx context.Context, req *mcp.CallToolRequest, args AppendToNoteArgs) (*mcp.CallToolResult, *tudidi.Note, error) {
+	note, err := h.api.AppendToNote(args.ID, args.Text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Appended to note: %s", note.Title)},
+		},
+	}, note, nil
+}
+
+func (h *Handlers) searchNotes(ctx context.Context, req *mcp.CallToolRequest, args SearchNotesArgs) (*mcp.CallToolResult, *NotesResult, error) {
+	notes, err := h.api.SearchNotes(args.Query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := NotesResult{
+		Notes: notes,
+		Count: len(notes),
+	}
+
+	prefix := fmt.Sprintf("Found %d notes matching '%s'", len(notes), args.Query)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatNotesText(notes, prefix)},
+		},
+	}, &result, nil
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// pageCursor marks where the previous page ended. LastID lets the next page
+// resume right after the last item even if earlier items were added or removed;
+// Offset is the fallback when that item no longer exists.
+type pageCursor struct {
+	LastID int `json:"last_id"`
+	Offset int `json:"offset"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (pageCursor, error) {
+	var cursor pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Offset < 0 {
+		return cursor, fmt.Errorf("invalid cursor")
+	}
+	return cursor, nil
+}
+
+// paginate returns the page of items following cursor together with the
+// cursor for the next page, which is empty on the last page. Items must
+// already be in a stable order.
+func paginate[T any](items []T, idOf func(T) int, limit int, cursor string) ([]T, string, error) {
+	if limit < 0 {
+		return nil, "", fmt.Errorf("limit must be positive, got: %d", limit)
+	}
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	start := 0
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		start = min(c.Offset, len(items))
+		for i, item := range items {
+			if idOf(item) == c.LastID {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := min(start+limit, len(items))
+	page := items[start:end]
+
+	next := ""
+	if end < len(items) && len(page) > 0 {
+		next = encodeCursor(pageCursor{LastID: idOf(page[len(page)-1]), Offset: end})
+	}
+	return page, next, nil
+}
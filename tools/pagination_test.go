@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func identity(id int) int { return id }
+
+func ids(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i + 1
+	}
+	return items
+}
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name          string
+		items         []int
+		limit         int
+		cursor        string
+		expected      []int
+		expectedNext  string
+		errorContains string
+	}{
+		{"First page", items, 2, "", []int{1, 2}, encodeCursor(pageCursor{LastID: 2, Offset: 2}), ""},
+		{"Resume after last ID", items, 2, encodeCursor(pageCursor{LastID: 2, Offset: 2}), []int{3, 4}, encodeCursor(pageCursor{LastID: 4, Offset: 4}), ""},
+		{"Last ID moved", []int{1, 3, 4, 5}, 2, encodeCursor(pageCursor{LastID: 3, Offset: 3}), []int{4, 5}, "", ""},
+		{"Last ID deleted falls back to offset", []int{1, 3, 4, 5}, 2, encodeCursor(pageCursor{LastID: 2, Offset: 2}), []int{4, 5}, "", ""},
+		{"Offset past the end", items, 2, encodeCursor(pageCursor{LastID: 99, Offset: 10}), []int{}, "", ""},
+		{"Exact last page", items, 5, "", []int{1, 2, 3, 4, 5}, "", ""},
+		{"Empty list", []int{}, 2, "", []int{}, "", ""},
+		{"Default limit", ids(60), 0, "", ids(defaultPageSize), encodeCursor(pageCursor{LastID: defaultPageSize, Offset: defaultPageSize}), ""},
+		{"Limit clamped", ids(250), 500, "", ids(maxPageSize), encodeCursor(pageCursor{LastID: maxPageSize, Offset: maxPageSize}), ""},
+		{"Negative limit", items, -1, "", nil, "", "limit must be positive"},
+		{"Not base64", items, 2, "%%%", nil, "", "invalid cursor"},
+		{"Not JSON", items, 2, "bm90IGpzb24", nil, "", "invalid cursor"},
+		{"Negative offset", items, 2, encodeCursor(pageCursor{LastID: 99, Offset: -1}), nil, "", "invalid cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, next, err := paginate(tt.items, identity, tt.limit, tt.cursor)
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(page, tt.expected) {
+				t.Errorf("Expected page %v, got %v", tt.expected, page)
+			}
+			if next != tt.expectedNext {
+				t.Errorf("Expected next cursor %q, got %q", tt.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestPaginateWalksAllPages(t *testing.T) {
+	items := ids(7)
+
+	var seen []int
+	cursor := ""
+	for pages := 0; pages < 10; pages++ {
+		page, next, err := paginate(items, identity, 3, cursor)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		seen = append(seen, page...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	if !reflect.DeepEqual(seen, items) {
+		t.Errorf("Expected to see every item once, got %v", seen)
+	}
+}
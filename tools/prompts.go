@@ -0,0 +1,361 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"tudidi_mcp/dates"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RegisterPrompts registers prompt templates for common task-management workflows.
+// Each prompt embeds live data from Tudidi so clients can offer them as slash commands.
+func (h *Handlers) RegisterPrompts(server *mcp.Server) {
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "daily_standup",
+		Title:       "Daily standup",
+		Description: "Summarise what was done yesterday, what is planned today and what is blocked",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "project", Description: "Only include this project (name or ID)"},
+			{Name: "tag", Description: "Only include tasks with this tag"},
+			{Name: "date", Description: "Day of the standup, e.g. today or 2026-10-16 (default today)"},
+		},
+	}, h.dailyStandupPrompt)
+
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "weekly_review",
+		Title:       "Weekly review",
+		Description: "Walk through a GTD-style weekly review of completed, new, slipping and stale work",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "project", Description: "Only review this project (name or ID)"},
+			{Name: "week_of", Description: "Any day in the week to review, e.g. last friday (default this week); later weeks cannot be reviewed"},
+			{Name: "stale_days", Description: "Flag open tasks not updated for this many days (default 14)"},
+		},
+	}, h.weeklyReviewPrompt)
+
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "triage_inbox",
+		Title:       "Triage inbox",
+		Description: "Decide what each inbox item is and where it belongs",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "limit", Description: "Maximum number of inbox items to triage (default all)"},
+		},
+	}, h.triageInboxPrompt)
+
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "plan_project",
+		Title:       "Plan project",
+		Description: "Turn a project into concrete next actions",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "project", Description: "Project to plan (name or ID)", Required: true},
+			{Name: "goal", Description: "What the project should achieve, if not already described"},
+		},
+	}, h.planProjectPrompt)
+}
+
+func (h *Handlers) dailyStandupPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	day, err := h.promptDate(args["date"])
+	if err != nil {
+		return nil, err
+	}
+	project, err := h.promptProject(args["project"])
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.api.GetTasks(nil)
+	if err != nil {
+		return nil, err
+	}
+	tasks = tasksInProject(tasks, project)
+	tag := strings.TrimSpace(args["tag"])
+	if tag != "" {
+		tasks = tasksWithTag(tasks, tag)
+	}
+
+	date := day.Format(dates.Layout)
+	yesterday := day.AddDate(0, 0, -1)
+	view := tudidi.BuildTodayView(tasks, date)
+
+	var text strings.Builder
+	scope := projectScope(project)
+	if tag != "" {
+		scope += fmt.Sprintf(" (tag: %s)", tag)
+	}
+	text.WriteString(fmt.Sprintf("Prepare my daily standup for %s%s.\n", formatDayHeading(date), scope))
+	text.WriteString("Use three short sections: Yesterday (what I finished), Today (what I will work on, most important first) and Blockers (waiting or overdue work). ")
+	text.WriteString("Only use the data below and refer to tasks by name.\n\n")
+
+	writePromptTasks(&text, fmt.Sprintf("Completed on %s", formatDayHeading(yesterday.Format(dates.Layout))), tudidi.CompletedOn(tasks, yesterday))
+	writePromptTasks(&text, "In progress", tasksWithStatus(tasks, tudidi.InProgress))
+	writePromptTasks(&text, "Overdue", view.Overdue)
+	writePromptTasks(&text, "Due today", view.DueToday)
+	writePromptTasks(&text, "On the Today list", view.Flagged)
+	writePromptTasks(&text, "Waiting", tasksWithStatus(tasks, tudidi.Waiting))
+
+	return promptResult("Daily standup for "+date, text.String()), nil
+}
+
+func (h *Handlers) weeklyReviewPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	day, err := h.promptDate(args["week_of"])
+	if err != nil {
+		return nil, err
+	}
+	// Weeks run Monday to Sunday, as in tudidi.BuildWeeklyReview
+	today := h.dates.Today()
+	if endOfWeek := today.AddDate(0, 0, 6-(int(today.Weekday())+6)%7); day.After(endOfWeek) {
+		return nil, fmt.Errorf("week_of cannot be after the current week, got %s", day.Format("2006-01-02"))
+	}
+	staleDays, err := promptInt(args["stale_days"], "stale_days")
+	if err != nil {
+		return nil, err
+	}
+	project, err := h.promptProject(args["project"])
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.api.GetTasks(nil)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	tasks = tasksInProject(tasks, project)
+	if project != nil {
+		projects = []tudidi.Project{*project}
+	}
+
+	// Review as of the end of the chosen day, or now when reviewing the current week
+	now := h.dates.Now()
+	if day.Before(today) {
+		now = day.Add(24*time.Hour - time.Second)
+	}
+	review := tudidi.BuildWeeklyReview(tasks, projects, now, staleDays)
+
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Run my weekly review%s with me, using the report below.\n", projectScope(project)))
+	text.WriteString("Go through it one section at a time:\n")
+	text.WriteString("1. Briefly acknowledge what got done.\n")
+	text.WriteString("2. For each slipping task, ask whether to reschedule, delegate or drop it.\n")
+	text.WriteString("3. For each stale task, ask whether it is still relevant.\n")
+	text.WriteString("4. For each project without a next action, suggest one.\n")
+	text.WriteString("Do not change anything until I confirm.\n\n")
+	text.WriteString(FormatWeeklyReviewText(review))
+
+	return promptResult(fmt.Sprintf("Weekly review for %s to %s", review.WeekStart, review.WeekEnd), text.String()), nil
+}
+
+func (h *Handlers) triageInboxPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	limit, err := promptInt(req.Params.Arguments["limit"], "limit")
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.api.GetInboxItems()
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := h.api.GetTags()
+	if err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	text.WriteString("Help me triage my inbox. For each item below, propose whether it becomes a task or a note, ")
+	text.WriteString("a clear title, which project it belongs to, and for tasks a priority, due date and tags where they are obvious. ")
+	text.WriteString("Suggest deleting items that need no action. Present the proposals as a list and wait for my confirmation ")
+	text.WriteString("before calling process_inbox_item or delete_inbox_item.\n\n")
+
+	text.WriteString(FormatInboxText(items))
+
+	text.WriteString("Active projects:\n")
+	for _, project := range projects {
+		if project.Active {
+			text.WriteString(fmt.Sprintf("- [%d] %s\n", project.ID, project.Name))
+		}
+	}
+	text.WriteString("\nExisting tags: ")
+	if len(tags) == 0 {
+		text.WriteString("none")
+	} else {
+		text.WriteString(formatTagNames(tags))
+	}
+	text.WriteString("\n")
+
+	return promptResult(fmt.Sprintf("Triage %d inbox items", len(items)), text.String()), nil
+}
+
+func (h *Handlers) planProjectPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	if strings.TrimSpace(args["project"]) == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	project, err := h.promptProject(args["project"])
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.api.GetTasks(&tudidi.TaskFilter{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	var open []tudidi.Task
+	for _, task := range tasks {
+		if !task.IsClosed() {
+			open = append(open, task)
+		}
+	}
+	notes, err := h.api.GetNotes()
+	if err != nil {
+		return nil, err
+	}
+	var projectNotes []tudidi.Note
+	for _, note := range notes {
+		if note.ProjectID == project.ID {
+			projectNotes = append(projectNotes, note)
+		}
+	}
+
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("Help me plan the project '%s'.\n", project.Name))
+	if goal := strings.TrimSpace(args["goal"]); goal != "" {
+		text.WriteString(fmt.Sprintf("The goal is: %s\n", goal))
+	}
+	text.WriteString("Using the project details, open tasks and notes below:\n")
+	text.WriteString("1. State the desired outcome in one sentence.\n")
+	text.WriteString("2. Point out gaps: work that is implied but has no task.\n")
+	text.WriteString("3. Propose concrete next actions, each small enough to finish in one sitting, with a priority and due date where it matters.\n")
+	text.WriteString("4. Mark which existing tasks should be broken down further.\n")
+	text.WriteString("Wait for my confirmation before calling create_task or break_down_task.\n\n")
+
+	text.WriteString("## Project\n\n")
+	text.WriteString(formatSingleProject(*project))
+	text.WriteString("\n## Open Tasks\n\n")
+	text.WriteString(FormatTasksText(open))
+	if len(projectNotes) > 0 {
+		text.WriteString("## Notes\n\n")
+		text.WriteString(FormatNotesText(projectNotes, fmt.Sprintf("Found %d notes", len(projectNotes))))
+	}
+
+	return promptResult("Plan project "+project.Name, text.String()), nil
+}
+
+// promptProject resolves an optional project argument given as an ID or a name
+func (h *Handlers) promptProject(value string) (*tudidi.Project, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if id, err := strconv.Atoi(value); err == nil {
+		return h.api.GetProject(id)
+	}
+	return h.api.ResolveProject(value)
+}
+
+// promptDate parses an optional date argument, defaulting to today
+func (h *Handlers) promptDate(value string) (time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return h.dates.Today(), nil
+	}
+	date, err := h.dates.Parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.ParseInLocation(dates.Layout, date, h.dates.Location())
+}
+
+// promptInt parses an optional non-negative integer argument; empty means 0
+func promptInt(value, name string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative number, got: %s", name, value)
+	}
+	return n, nil
+}
+
+func promptResult(description, text string) *mcp.GetPromptResult {
+	return &mcp.GetPromptResult{
+		Description: description,
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: text}},
+		},
+	}
+}
+
+func projectScope(project *tudidi.Project) string {
+	if project == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (project: %s)", project.Name)
+}
+
+// writePromptTasks writes a heading and one line per task, or "(none)"
+func writePromptTasks(text *strings.Builder, heading string, tasks []tudidi.Task) {
+	text.WriteString(fmt.Sprintf("%s:\n", heading))
+	if len(tasks) == 0 {
+		text.WriteString("- (none)\n\n")
+		return
+	}
+	for _, task := range tasks {
+		line := fmt.Sprintf("- [%d] %s", task.ID, task.Name)
+		if task.DueDate != "" {
+			line += fmt.Sprintf(" (due %s)", tudidi.DateOnly(task.DueDate))
+		}
+		text.WriteString(line + "\n")
+	}
+	text.WriteString("\n")
+}
+
+func tasksInProject(tasks []tudidi.Task, project *tudidi.Project) []tudidi.Task {
+	if project == nil {
+		return tasks
+	}
+	var filtered []tudidi.Task
+	for _, task := range tasks {
+		if task.ProjectID == project.ID {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+func tasksWithStatus(tasks []tudidi.Task, status tudidi.Status) []tudidi.Task {
+	code, _ := status.Code()
+	var filtered []tudidi.Task
+	for _, task := range tasks {
+		if task.Status == code {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+func tasksWithTag(tasks []tudidi.Task, tag string) []tudidi.Task {
+	var filtered []tudidi.Task
+	for _, task := range tasks {
+		if task.HasTag(tag) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
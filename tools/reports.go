@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"tudidi_mcp/dates"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	defaultUpcomingDays = 7
+	maxUpcomingDays     = 365
+	defaultStatsDays    = 30
+	maxStatsDays        = 366
+)
+
+type WeeklyReviewArgs struct {
+	StaleDays int `json:"stale_days,omitempty" jsonschema:"Flag open tasks not updated for this many days (default 14)"`
+}
+
+type TaskStatsArgs struct {
+	Since string `json:"since,omitempty" jsonschema:"Start of the period: YYYY-MM-DD or an expression such as 30 days ago or start of month (default 30 days ago)"`
+}
+
+type UpcomingTasksArgs struct {
+	Days int `json:"days,omitempty" jsonschema:"Number of days ahead to include, counting from today (default 7, max 365)"`
+}
+
+func (h *Handlers) getOverdueTasks(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *tudidi.DueReport, error) {
+	yesterday := h.dates.Today().AddDate(0, 0, -1).Format(dates.Layout)
+
+	report, err := h.dueReport("", yesterday)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	title := fmt.Sprintf("%d overdue tasks", report.Count)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatDueReportText(report, title)},
+		},
+	}, report, nil
+}
+
+func (h *Handlers) getUpcomingTasks(ctx context.Context, req *mcp.CallToolRequest, args UpcomingTasksArgs) (*mcp.CallToolResult, *tudidi.DueReport, error) {
+	days := args.Days
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	if days < 0 || days > maxUpcomingDays {
+		return nil, nil, fmt.Errorf("days must be between 1 and %d, got: %d", maxUpcomingDays, args.Days)
+	}
+
+	// days counts today, so the range ends days-1 days from now
+	today := h.dates.Today()
+	report, err := h.dueReport(today.Format(dates.Layout), today.AddDate(0, 0, days-1).Format(dates.Layout))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	title := fmt.Sprintf("%d tasks due in the next %d days", report.Count, days)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatDueReportText(report, title)},
+		},
+	}, report, nil
+}
+
+func (h *Handlers) weeklyReview(ctx context.Context, req *mcp.CallToolRequest, args WeeklyReviewArgs) (*mcp.CallToolResult, *tudidi.WeeklyReview, error) {
+	if args.StaleDays < 0 {
+		return nil, nil, fmt.Errorf("stale_days cannot be negative")
+	}
+
+	tasks, err := h.api.GetTasks(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	review := tudidi.BuildWeeklyReview(tasks, projects, h.dates.Now(), args.StaleDays)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatWeeklyReviewText(review)},
+		},
+	}, &review, nil
+}
+
+func (h *Handlers) getTaskStats(ctx context.Context, req *mcp.CallToolRequest, args TaskStatsArgs) (*mcp.CallToolResult, *tudidi.TaskStats, error) {
+	today := h.dates.Today()
+	since := today.AddDate(0, 0, -(defaultStatsDays - 1))
+	if args.Since != "" {
+		date, err := h.dates.Parse(args.Since)
+		if err != nil {
+			return nil, nil, err
+		}
+		if since, err = time.ParseInLocation(dates.Layout, date, today.Location()); err != nil {
+			return nil, nil, err
+		}
+	}
+	if since.After(today) {
+		return nil, nil, fmt.Errorf("since cannot be in the future, got: %s", since.Format(dates.Layout))
+	}
+	if since.Before(today.AddDate(0, 0, -(maxStatsDays - 1))) {
+		return nil, nil, fmt.Errorf("since can be at most %d days ago, got: %s", maxStatsDays, since.Format(dates.Layout))
+	}
+
+	tasks, err := h.api.GetTasks(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := tudidi.ComputeTaskStats(tasks, projects, since, h.dates.Now())
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatTaskStatsText(stats)},
+		},
+	}, &stats, nil
+}
+
+// dueReport fetches open tasks and projects and groups the tasks due between from and to
+func (h *Handlers) dueReport(from, to string) (*tudidi.DueReport, error) {
+	tasks, err := h.api.GetTasks(&tudidi.TaskFilter{DueAfter: from, DueBefore: to})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	report := tudidi.BuildDueReport(tasks, projects, from, to)
+	return &report, nil
+}
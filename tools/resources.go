@@ -0,0 +1,157 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	resourceScheme     = "tudidi"
+	projectsURI        = "tudidi://projects"
+	taskURITemplate    = "tudidi://task/{id}"
+	projectURITemplate = "tudidi://project/{id}"
+	jsonMIMEType       = "application/json"
+)
+
+// ProjectDetails is a project together with its open tasks
+type ProjectDetails struct {
+	tudidi.Project
+	Tasks []tudidi.Task `json:"tasks" jsonschema:"Open tasks in the project"`
+}
+
+// RegisterResources exposes tasks and projects as MCP resources so clients can attach them as context
+func (h *Handlers) RegisterResources(server *mcp.Server) {
+	server.AddResource(&mcp.Resource{
+		URI:         projectsURI,
+		Name:        "projects",
+		Title:       "Projects",
+		Description: "All Tudidi projects",
+		MIMEType:    jsonMIMEType,
+	}, h.readProjects)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: taskURITemplate,
+		Name:        "task",
+		Title:       "Task",
+		Description: "A Tudidi task and its subtasks",
+		MIMEType:    jsonMIMEType,
+	}, h.readTask)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: projectURITemplate,
+		Name:        "project",
+		Title:       "Project",
+		Description: "A Tudidi project and its open tasks",
+		MIMEType:    jsonMIMEType,
+	}, h.readProject)
+}
+
+func (h *Handlers) readProjects(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	projects, err := h.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	return jsonResource(req.Params.URI, projects)
+}
+
+func (h *Handlers) readTask(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	id, err := resourceID(uri, "task")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := h.api.GetTask(id)
+	if err != nil {
+		return nil, resourceError(uri, err)
+	}
+	subtasks, err := h.api.GetSubtasks(id)
+	if err != nil {
+		return nil, resourceError(uri, err)
+	}
+
+	return jsonResource(uri, TaskDetails{Task: *task, Subtasks: subtasks})
+}
+
+func (h *Handlers) readProject(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	id, err := resourceID(uri, "project")
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := h.api.GetProject(id)
+	if err != nil {
+		return nil, resourceError(uri, err)
+	}
+	tasks, err := h.api.GetTasks(&tudidi.TaskFilter{ProjectID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonResource(uri, ProjectDetails{Project: *project, Tasks: tasks})
+}
+
+func taskURI(id int) string {
+	return fmt.Sprintf("%s://task/%d", resourceScheme, id)
+}
+
+func projectURI(id int) string {
+	return fmt.Sprintf("%s://project/%d", resourceScheme, id)
+}
+
+// isResourceURI reports whether uri names one of the published resources
+func isResourceURI(uri string) bool {
+	if uri == projectsURI {
+		return true
+	}
+	for _, kind := range []string{"task", "project"} {
+		if _, err := resourceID(uri, kind); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceID extracts the numeric ID from a URI such as tudidi://task/42
+func resourceID(uri, kind string) (int, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != resourceScheme || parsed.Host != kind {
+		return 0, mcp.ResourceNotFoundError(uri)
+	}
+
+	id, err := strconv.Atoi(strings.Trim(parsed.Path, "/"))
+	if err != nil || id <= 0 {
+		return 0, mcp.ResourceNotFoundError(uri)
+	}
+	return id, nil
+}
+
+// resourceError reports Tudidi 404s as MCP resource-not-found errors
+func resourceError(uri string, err error) error {
+	if errors.Is(err, tudidi.ErrNotFound) {
+		return mcp.ResourceNotFoundError(uri)
+	}
+	return err
+}
+
+func jsonResource(uri string, value any) (*mcp.ReadResourceResult, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: jsonMIMEType, Text: string(data)},
+		},
+	}, nil
+}
@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Watcher polls Tudidi for changes and notifies sessions subscribed to the affected resources.
+// The server routes notifications to subscribers; the watcher mirrors its subscriptions,
+// including dropping them when a session ends, so it can stop polling when nobody is listening.
+type Watcher struct {
+	api      *tudidi.API
+	interval time.Duration
+
+	mu       sync.Mutex
+	sessions map[*mcp.ServerSession]map[string]bool // session -> subscribed URIs
+	snapshot *tudidi.Snapshot
+}
+
+// NewWatcher creates a watcher that polls every interval once a client subscribes
+func NewWatcher(api *tudidi.API, interval time.Duration) *Watcher {
+	return &Watcher{
+		api:      api,
+		interval: interval,
+		sessions: make(map[*mcp.ServerSession]map[string]bool),
+	}
+}
+
+// Subscribe is used as the server's SubscribeHandler; it only accepts URIs of published resources.
+// The first subscription takes the baseline snapshot, so every later change is reported.
+func (w *Watcher) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	uri := req.Params.URI
+	if !isResourceURI(uri) {
+		return mcp.ResourceNotFoundError(uri)
+	}
+	if err := w.takeBaseline(); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.sessions[req.Session] == nil {
+		w.sessions[req.Session] = make(map[string]bool)
+		go w.forgetOnDisconnect(req.Session)
+	}
+	w.sessions[req.Session][uri] = true
+	return nil
+}
+
+// Unsubscribe is used as the server's UnsubscribeHandler
+func (w *Watcher) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.sessions[req.Session], req.Params.URI)
+	w.resetIfUnwatched()
+	return nil
+}
+
+// forgetOnDisconnect drops a session's subscriptions once it ends, as the server does
+func (w *Watcher) forgetOnDisconnect(session *mcp.ServerSession) {
+	session.Wait()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.sessions, session)
+	w.resetIfUnwatched()
+}
+
+// resetIfUnwatched drops the snapshot once nobody is subscribed, so the next
+// subscription starts from a fresh baseline. It must be called with w.mu held.
+func (w *Watcher) resetIfUnwatched() {
+	if !w.subscribed() {
+		w.snapshot = nil
+	}
+}
+
+// subscribed reports whether any session has a subscription. It must be called with w.mu held.
+func (w *Watcher) subscribed() bool {
+	for _, uris := range w.sessions {
+		if len(uris) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// takeBaseline records the current tasks and projects unless a snapshot already exists
+func (w *Watcher) takeBaseline() error {
+	w.mu.Lock()
+	hasSnapshot := w.snapshot != nil
+	w.mu.Unlock()
+	if hasSnapshot {
+		return nil
+	}
+
+	snapshot, err := w.takeSnapshot()
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.snapshot == nil {
+		w.snapshot = snapshot
+	}
+	return nil
+}
+
+func (w *Watcher) takeSnapshot() (*tudidi.Snapshot, error) {
+	tasks, err := w.api.GetTasks(nil)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := w.api.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	return tudidi.TakeSnapshot(tasks, projects), nil
+}
+
+// Run polls until ctx is cancelled, sending resources/updated notifications through server
+func (w *Watcher) Run(ctx context.Context, server *mcp.Server) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Poll(ctx, server); err != nil {
+				log.Printf("Failed to poll for resource changes: %v", err)
+			}
+		}
+	}
+}
+
+// Poll takes a snapshot of tasks and projects and notifies subscribers of anything
+// that changed since the previous snapshot. It does nothing while nobody is subscribed.
+func (w *Watcher) Poll(ctx context.Context, server *mcp.Server) error {
+	if !w.hasSubscriptions() {
+		return nil
+	}
+
+	snapshot, err := w.takeSnapshot()
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	previous := w.snapshot
+	w.snapshot = snapshot
+	w.mu.Unlock()
+
+	if previous == nil {
+		return nil
+	}
+
+	// The server only notifies sessions subscribed to each URI
+	for _, uri := range changedURIs(previous.Diff(snapshot)) {
+		server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri})
+	}
+	return nil
+}
+
+func (w *Watcher) hasSubscriptions() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.subscribed()
+}
+
+// changedURIs maps changes to the resource URIs that now read differently
+func changedURIs(changes tudidi.Changes) []string {
+	var uris []string
+	if changes.ProjectListChanged {
+		uris = append(uris, projectsURI)
+	}
+	for _, id := range changes.Projects {
+		uris = append(uris, projectURI(id))
+	}
+	for _, id := range changes.Tasks {
+		uris = append(uris, taskURI(id))
+	}
+	return uris
+}
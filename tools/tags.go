@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type TagsResult struct {
+	Tags  []tudidi.Tag `json:"tags" jsonschema:"List of tags"`
+	Count int          `json:"count" jsonschema:"Number of tags"`
+}
+
+type CreateTagArgs struct {
+	Name string `json:"name" jsonschema:"Tag name, e.g. @home"`
+}
+
+type TaskTagArgs struct {
+	TaskID int    `json:"task_id" jsonschema:"Task ID"`
+	Tag    string `json:"tag" jsonschema:"Tag name"`
+}
+
+func (h *Handlers) listTags(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *TagsResult, error) {
+	tags, err := h.api.GetTags()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := TagsResult{
+		Tags:  tags,
+		Count: len(tags),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatTagsText(tags)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) createTag(ctx context.Context, req *mcp.CallToolRequest, args CreateTagArgs) (*mcp.CallToolResult, *tudidi.Tag, error) {
+	tag, err := h.api.CreateTag(args.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Created tag: %s", tag.Name)},
+		},
+	}, tag, nil
+}
+
+func (h *Handlers) tagTask(ctx context.Context, req *mcp.CallToolRequest, args TaskTagArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	task, err := h.api.TagTask(args.TaskID, args.Tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Tagged task '%s' with %s", task.Name, args.Tag)},
+		},
+	}, task, nil
+}
+
+func (h *Handlers) untagTask(ctx context.Context, req *mcp.CallToolRequest, args TaskTagArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	task, err := h.api.UntagTask(args.TaskID, args.Tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Removed tag %s from task '%s'", args.Tag, task.Name)},
+		},
+	}, task, nil
+}
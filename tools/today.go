@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"tudidi_mcp/dates"
+	"tudidi_mcp/tudidi"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const defaultPlanSize = 5
+
+type TodayResult struct {
+	tudidi.TodayView
+	Count int `json:"count" jsonschema:"Number of tasks across all groups"`
+}
+
+type PlanMyDayArgs struct {
+	MaxTasks int  `json:"max_tasks,omitempty" jsonschema:"Maximum number of tasks to propose (default 5)"`
+	Apply    bool `json:"apply,omitempty" jsonschema:"Add the proposed tasks to the Today list instead of only proposing them"`
+}
+
+type DayPlanResult struct {
+	Date    string               `json:"date" jsonschema:"Day being planned (YYYY-MM-DD)"`
+	Tasks   []tudidi.PlannedTask `json:"tasks" jsonschema:"Proposed tasks, most pressing first"`
+	Count   int                  `json:"count" jsonschema:"Number of proposed tasks"`
+	Applied bool                 `json:"applied" jsonschema:"Whether the tasks were added to the Today list"`
+	Added   []int                `json:"added,omitempty" jsonschema:"IDs of tasks newly added to the Today list"`
+}
+
+func (h *Handlers) getToday(ctx context.Context, req *mcp.CallToolRequest, args any) (*mcp.CallToolResult, *TodayResult, error) {
+	tasks, err := h.api.GetTasks(&tudidi.TaskFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	view := tudidi.BuildTodayView(tasks, h.dates.Today().Format(dates.Layout))
+	result := TodayResult{
+		TodayView: view,
+		Count:     view.Count(),
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatTodayText(view)},
+		},
+	}, &result, nil
+}
+
+func (h *Handlers) addToToday(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	task, err := h.api.SetTaskToday(args.ID, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Added task '%s' to Today", task.Name)},
+		},
+	}, task, nil
+}
+
+func (h *Handlers) removeFromToday(ctx context.Context, req *mcp.CallToolRequest, args TaskIDArgs) (*mcp.CallToolResult, *tudidi.Task, error) {
+	task, err := h.api.SetTaskToday(args.ID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Removed task '%s' from Today", task.Name)},
+		},
+	}, task, nil
+}
+
+func (h *Handlers) planMyDay(ctx context.Context, req *mcp.CallToolRequest, args PlanMyDayArgs) (*mcp.CallToolResult, *DayPlanResult, error) {
+	if args.MaxTasks < 0 {
+		return nil, nil, fmt.Errorf("max_tasks cannot be negative")
+	}
+	limit := args.MaxTasks
+	if limit == 0 {
+		limit = defaultPlanSize
+	}
+
+	tasks, err := h.api.GetTasks(&tudidi.TaskFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	today := h.dates.Today().Format(dates.Layout)
+	plan := tudidi.PlanDay(tasks, today, limit)
+	result := DayPlanResult{
+		Date:  today,
+		Tasks: plan,
+		Count: len(plan),
+	}
+
+	if args.Apply {
+		for _, item := range plan {
+			if item.Task.Today {
+				continue
+			}
+			if _, err := h.api.SetTaskToday(item.Task.ID, true); err != nil {
+				return nil, nil, fmt.Errorf("added %d of %d planned tasks to Today before failing: %w", len(result.Added), len(plan), err)
+			}
+			result.Added = append(result.Added, item.Task.ID)
+		}
+		result.Applied = true
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: FormatDayPlanText(today, plan, result.Applied)},
+		},
+	}, &result, nil
+}
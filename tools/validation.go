@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"tudidi_mcp/dates"
+	"tudidi_mcp/tudidi"
+)
+
+// parsePriorityArg validates an optional priority argument; empty means "not set"
+func parsePriorityArg(value string) (tudidi.Priority, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+	return tudidi.ParsePriority(value)
+}
+
+// parseStatusArg validates an optional status argument, defaulting to not started
+func parseStatusArg(value string) (tudidi.Status, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return tudidi.NotStarted, nil
+	}
+
+	status := tudidi.Status(value)
+	if _, err := status.Code(); err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+// parseTagsArg turns tag names into tags, dropping duplicates
+func parseTagsArg(names []string) ([]tudidi.Tag, error) {
+	var tags []tudidi.Tag
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("tag name cannot be empty")
+		}
+		if seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		tags = append(tags, tudidi.Tag{Name: name})
+	}
+	return tags, nil
+}
+
+// parseDateArg converts an optional date expression such as "2026-10-20" or
+// "next friday" to YYYY-MM-DD; empty means "not set"
+func parseDateArg(parser *dates.Parser, value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+	return parser.Parse(value)
+}
+
+// parseRecurrenceArg converts optional recurrence arguments into Tudidi's recurrence fields
+func parseRecurrenceArg(parser *dates.Parser, args *RecurrenceArgs) (*tudidi.Recurrence, error) {
+	if args == nil {
+		return nil, nil
+	}
+
+	recurrence := tudidi.Recurrence{
+		RecurrenceType:     tudidi.RecurrenceType(strings.ToLower(strings.TrimSpace(args.Type))),
+		RecurrenceInterval: args.Interval,
+	}
+	if recurrence.RecurrenceType == "" {
+		return nil, fmt.Errorf("recurrence type is required")
+	}
+	if !recurrence.IsRecurring() {
+		return &recurrence, nil
+	}
+
+	if recurrence.RecurrenceInterval == 0 {
+		recurrence.RecurrenceInterval = 1
+	}
+
+	endDate, err := parseDateArg(parser, args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	recurrence.RecurrenceEndDate = endDate
+
+	if args.Weekday != "" {
+		weekday, err := dates.ParseWeekday(args.Weekday)
+		if err != nil {
+			return nil, err
+		}
+		day := int(weekday)
+		recurrence.RecurrenceWeekday = &day
+	}
+	if args.MonthDay != 0 {
+		monthDay := args.MonthDay
+		recurrence.RecurrenceMonthDay = &monthDay
+	}
+	if args.WeekOfMonth != 0 {
+		weekOfMonth := args.WeekOfMonth
+		recurrence.RecurrenceWeekOfMonth = &weekOfMonth
+	}
+
+	if err := recurrence.Validate(); err != nil {
+		return nil, err
+	}
+	return &recurrence, nil
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"tudidi_mcp/dates"
+	"tudidi_mcp/tudidi"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestParseRecurrenceArg(t *testing.T) {
+	// Friday 16 October 2026
+	parser := dates.NewParser(time.UTC).WithClock(func() time.Time {
+		return time.Date(2026, time.October, 16, 10, 30, 0, 0, time.UTC)
+	})
+
+	tests := []struct {
+		name          string
+		input         *RecurrenceArgs
+		expected      *tudidi.Recurrence
+		errorContains string
+	}{
+		{"Not given", nil, nil, ""},
+		{
+			"Every 2 weeks on Mon",
+			&RecurrenceArgs{Type: "Weekly", Interval: 2, Weekday: "mon"},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceWeekly, RecurrenceInterval: 2, RecurrenceWeekday: intPtr(1)},
+			"",
+		},
+		{
+			"Monthly on a day, interval defaults to 1",
+			&RecurrenceArgs{Type: "monthly", MonthDay: 15},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthly, RecurrenceInterval: 1, RecurrenceMonthDay: intPtr(15)},
+			"",
+		},
+		{
+			"Monthly weekday and week of month",
+			&RecurrenceArgs{Type: "monthly_weekday", Weekday: "thursday", WeekOfMonth: 3},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceMonthlyWeekday, RecurrenceInterval: 1, RecurrenceWeekday: intPtr(4), RecurrenceWeekOfMonth: intPtr(3)},
+			"",
+		},
+		{
+			"End date",
+			&RecurrenceArgs{Type: "daily", EndDate: "2026-12-31"},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceDaily, RecurrenceInterval: 1, RecurrenceEndDate: "2026-12-31"},
+			"",
+		},
+		{
+			"End date expression",
+			&RecurrenceArgs{Type: "daily", EndDate: "next friday"},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceDaily, RecurrenceInterval: 1, RecurrenceEndDate: "2026-10-23"},
+			"",
+		},
+		{
+			"None ignores the other fields",
+			&RecurrenceArgs{Type: "none", Weekday: "mon"},
+			&tudidi.Recurrence{RecurrenceType: tudidi.RecurrenceNone},
+			"",
+		},
+		{"Missing type", &RecurrenceArgs{Interval: 2}, nil, "recurrence type is required"},
+		{"Unknown type", &RecurrenceArgs{Type: "yearly"}, nil, "invalid recurrence type"},
+		{"Bad weekday", &RecurrenceArgs{Type: "weekly", Weekday: "someday"}, nil, "invalid weekday"},
+		{"Monthly weekday without week", &RecurrenceArgs{Type: "monthly_weekday", Weekday: "mon"}, nil, "requires a weekday and a week of month"},
+		{"Week of month out of range", &RecurrenceArgs{Type: "monthly_weekday", Weekday: "mon", WeekOfMonth: 6}, nil, "between 1 and 5"},
+		{"Negative interval", &RecurrenceArgs{Type: "daily", Interval: -1}, nil, "interval must be positive"},
+		{"Bad end date", &RecurrenceArgs{Type: "daily", EndDate: "whenever"}, nil, "could not understand date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := parseRecurrenceArg(parser, tt.input)
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(recurrence, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, recurrence)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package tudidi
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -14,6 +15,9 @@ type API struct {
 	readonly bool
 }
 
+// ErrNotFound is returned (wrapped) when Tudidi answers 404 for the requested item
+var ErrNotFound = errors.New("resource not found")
+
 type Priority string
 
 const (
@@ -22,15 +26,85 @@ const (
 	PriorityHigh   Priority = "high"
 )
 
+// ParsePriority validates a priority name (low/medium/high), case-insensitively
+func ParsePriority(value string) (Priority, error) {
+	switch p := Priority(strings.ToLower(strings.TrimSpace(value))); p {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid priority '%s': must be one of low, medium, high", value)
+	}
+}
+
+// priorityCodes maps priority names to the integer codes Tudidi stores on tasks
+var priorityCodes = map[Priority]int{
+	PriorityLow:    0,
+	PriorityMedium: 1,
+	PriorityHigh:   2,
+}
+
+// Code returns Tudidi's integer priority code for the priority
+func (p Priority) Code() (int, error) {
+	code, ok := priorityCodes[p]
+	if !ok {
+		return 0, fmt.Errorf("invalid priority '%s': must be one of low, medium, high", p)
+	}
+	return code, nil
+}
+
+// PriorityFromCode converts Tudidi's integer task priority back to a priority name
+func PriorityFromCode(code int) (Priority, error) {
+	for priority, c := range priorityCodes {
+		if c == code {
+			return priority, nil
+		}
+	}
+	return "", fmt.Errorf("unknown priority code: %d", code)
+}
+
 type Status string
 
 const (
 	NotStarted Status = "not_started"
 	InProgress Status = "in_progress"
 	Completed  Status = "completed"
+	Archived   Status = "archived"
+	Waiting    Status = "waiting"
 )
 
-type Tag struct{}
+// statusCodes maps status names to the integer codes Tudidi stores on tasks
+var statusCodes = map[Status]int{
+	NotStarted: 0,
+	InProgress: 1,
+	Completed:  2,
+	Archived:   3,
+	Waiting:    4,
+}
+
+// Code returns Tudidi's integer status code for the status
+func (s Status) Code() (int, error) {
+	code, ok := statusCodes[s]
+	if !ok {
+		return 0, fmt.Errorf("invalid status '%s': must be one of not_started, in_progress, completed, archived, waiting", s)
+	}
+	return code, nil
+}
+
+// StatusFromCode converts Tudidi's integer status code back to a status name
+func StatusFromCode(code int) (Status, error) {
+	for status, c := range statusCodes {
+		if c == code {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown status code: %d", code)
+}
+
+type Tag struct {
+	ID   int    `json:"id,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+	Name string `json:"name"`
+}
 
 type Task struct {
 	ID           int    `json:"id"`
@@ -38,9 +112,9 @@ type Task struct {
 	Name         string `json:"name"`
 	Note         string `json:"note,omitempty"`
 	DueDate      string `json:"due_date,omitempty"`
-	Today        bool   `json:"today,omitempty"`
+	Today        bool   `json:"today"`
 	Priority     int    `json:"priority"`
-	Status       int    `json:"status,omitempty"`
+	Status       int    `json:"status"`
 	ProjectID    int    `json:"project_id,omitempty"`
 	UserID       int    `json:"user_id,omitempty"`
 	CompletedAt  string `json:"completed_at,omitempty"`
@@ -48,6 +122,7 @@ type Task struct {
 	UpdatedAt    string `json:"updated_at,omitempty"`
 	Tags         []Tag  `json:"tags"`
 	ParentTaskID int    `json:"parent_task_id,omitempty"`
+	Recurrence
 }
 
 type Project struct {
@@ -66,20 +141,214 @@ type Project struct {
 	UpdatedAt         string   `json:"updated_at,omitempty"`
 }
 
+type CreateProjectRequest struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Active       bool     `json:"active"`
+	PinToSidebar bool     `json:"pin_to_sidebar,omitempty"`
+	Priority     Priority `json:"priority,omitempty"`
+	DueDateAt    string   `json:"due_date_at,omitempty"`
+	AreaID       int      `json:"area_id,omitempty"`
+}
+
+// UpdateProjectRequest only sends the fields that are set, so Tudidi leaves the rest untouched
+type UpdateProjectRequest struct {
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Active       *bool    `json:"active,omitempty"`
+	PinToSidebar *bool    `json:"pin_to_sidebar,omitempty"`
+	Priority     Priority `json:"priority,omitempty"`
+	DueDateAt    string   `json:"due_date_at,omitempty"`
+	AreaID       *int     `json:"area_id,omitempty"`
+}
+
+func (r UpdateProjectRequest) isEmpty() bool {
+	return r.Name == "" && r.Description == "" && r.Active == nil && r.PinToSidebar == nil &&
+		r.Priority == "" && r.DueDateAt == "" && r.AreaID == nil
+}
+
 type Projects struct {
 	Projects []Project `json:"projects"`
 }
 
 type CreateTaskRequest struct {
-	Name      string `json:"name"`
-	Note      string `json:"note,omitempty"`
-	ProjectID int    `json:"project_id"`
-	Status    Status `json:"status"`
+	Name         string   `json:"name"`
+	Note         string   `json:"note,omitempty"`
+	ProjectID    int      `json:"project_id"`
+	Status       Status   `json:"status,omitempty"`
+	Priority     Priority `json:"priority,omitempty"`
+	DueDate      string   `json:"due_date,omitempty"`
+	Today        bool     `json:"today,omitempty"`
+	Tags         []Tag    `json:"tags,omitempty"`
+	ParentTaskID int      `json:"parent_task_id,omitempty"`
+	Recurrence
+}
+
+// MarshalJSON sends status and priority as Tudidi's integer codes, like task updates do,
+// and leaves them out when they are not set
+func (r CreateTaskRequest) MarshalJSON() ([]byte, error) {
+	type fields CreateTaskRequest
+	payload := struct {
+		fields
+		Status   *int `json:"status,omitempty"`
+		Priority *int `json:"priority,omitempty"`
+	}{fields: fields(r)}
+
+	if r.Status != "" {
+		code, err := r.Status.Code()
+		if err != nil {
+			return nil, err
+		}
+		payload.Status = &code
+	}
+	if r.Priority != "" {
+		code, err := r.Priority.Code()
+		if err != nil {
+			return nil, err
+		}
+		payload.Priority = &code
+	}
+	return json.Marshal(payload)
+}
+
+func (r CreateTaskRequest) validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("task name cannot be empty")
+	}
+	if r.Status != "" {
+		if _, err := r.Status.Code(); err != nil {
+			return err
+		}
+		if r.Status == Completed || r.Status == Archived {
+			return fmt.Errorf("new tasks cannot be %s; create the task and then change its status", r.Status)
+		}
+	}
+	if r.Priority != "" {
+		if _, err := r.Priority.Code(); err != nil {
+			return err
+		}
+	}
+	if r.DueDate != "" {
+		if err := validateDate(r.DueDate); err != nil {
+			return fmt.Errorf("invalid due date: %w", err)
+		}
+	}
+	for _, tag := range r.Tags {
+		if strings.TrimSpace(tag.Name) == "" {
+			return fmt.Errorf("tag name cannot be empty")
+		}
+	}
+	return r.Recurrence.Validate()
 }
 
+// UpdateTaskRequest describes a partial task update: nil fields are left untouched.
+// An empty DueDate clears the due date, a zero ProjectID takes the task out of its
+// project and a zero ParentTaskID detaches it from its parent.
 type UpdateTaskRequest struct {
-	Name string `json:"name,omitempty"`
-	Note string `json:"note,omitempty"`
+	Name         *string
+	Note         *string
+	Priority     *Priority
+	DueDate      *string
+	Today        *bool
+	ProjectID    *int
+	ParentTaskID *int
+	Recurrence   *Recurrence
+	Status       *Status
+	// Reopen moves a completed or archived task back to not started; open tasks keep their status
+	Reopen bool
+}
+
+func (r UpdateTaskRequest) isEmpty() bool {
+	return r.Name == nil && r.Note == nil && r.Priority == nil && r.DueDate == nil && r.Today == nil &&
+		r.ProjectID == nil && r.ParentTaskID == nil && r.Recurrence == nil && r.Status == nil && !r.Reopen
+}
+
+func (r UpdateTaskRequest) validate(id int) error {
+	if r.isEmpty() {
+		return fmt.Errorf("no fields to update")
+	}
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return fmt.Errorf("task name cannot be empty")
+	}
+	if r.Priority != nil {
+		if _, err := r.Priority.Code(); err != nil {
+			return err
+		}
+	}
+	if r.DueDate != nil && *r.DueDate != "" {
+		if err := validateDate(*r.DueDate); err != nil {
+			return fmt.Errorf("invalid due date: %w", err)
+		}
+	}
+	if r.ProjectID != nil && *r.ProjectID < 0 {
+		return fmt.Errorf("project ID cannot be negative, got: %d", *r.ProjectID)
+	}
+	if r.ParentTaskID != nil {
+		if *r.ParentTaskID < 0 {
+			return fmt.Errorf("parent task ID cannot be negative, got: %d", *r.ParentTaskID)
+		}
+		if *r.ParentTaskID == id {
+			return fmt.Errorf("a task cannot be its own parent")
+		}
+	}
+	if r.Recurrence != nil {
+		if err := r.Recurrence.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Status != nil {
+		if _, err := r.Status.Code(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clears lists the fields the request empties
+func (r UpdateTaskRequest) clears() taskClears {
+	return taskClears{
+		DueDate:    r.DueDate != nil && *r.DueDate == "",
+		Project:    r.ProjectID != nil && *r.ProjectID == 0,
+		ParentTask: r.ParentTaskID != nil && *r.ParentTaskID == 0,
+	}
+}
+
+// apply copies the set fields onto task; the request must have been validated first
+func (r UpdateTaskRequest) apply(task *Task) {
+	if r.Name != nil {
+		task.Name = strings.TrimSpace(*r.Name)
+	}
+	if r.Note != nil {
+		task.Note = *r.Note
+	}
+	if r.Priority != nil {
+		task.Priority, _ = r.Priority.Code()
+	}
+	if r.DueDate != nil {
+		task.DueDate = *r.DueDate
+	}
+	if r.Today != nil {
+		task.Today = *r.Today
+	}
+	if r.ProjectID != nil {
+		task.ProjectID = *r.ProjectID
+	}
+	if r.ParentTaskID != nil {
+		task.ParentTaskID = *r.ParentTaskID
+	}
+	if r.Recurrence != nil {
+		task.Recurrence = *r.Recurrence
+	}
+	if r.Status != nil {
+		task.Status, _ = r.Status.Code()
+	}
+	if r.Reopen && task.IsClosed() {
+		task.Status, _ = NotStarted.Code()
+	}
+	if !task.IsClosed() {
+		// Don't send a stale completion time back with the reopened task
+		task.CompletedAt = ""
+	}
 }
 
 func NewAPI(client *auth.Client, readonly bool) *API {
@@ -163,7 +432,7 @@ func (api *API) handleResponse(resp *http.Response, result interface{}, expected
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("resource not found")
+		return ErrNotFound
 	}
 
 	if !statusOK {
@@ -182,12 +451,68 @@ func (api *API) handleResponse(resp *http.Response, result interface{}, expected
 	return nil
 }
 
-func (api *API) GetTasks() ([]Task, error) {
+// GetTasks lists tasks. A nil filter returns every task, including completed ones.
+func (api *API) GetTasks(filter *TaskFilter) ([]Task, error) {
+	endpoint := "/api/tasks"
+	if filter != nil {
+		f := *filter
+		f.Tag = strings.TrimSpace(f.Tag)
+		if err := f.validate(); err != nil {
+			return nil, err
+		}
+		if query := f.query(); len(query) > 0 {
+			endpoint += "?" + query.Encode()
+		}
+		filter = &f
+	}
+
 	var resp GetTasksResponse
-	if err := api.doGet("/api/tasks", &resp); err != nil {
+	if err := api.doGet(endpoint, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get tasks: %w", err)
 	}
-	return resp.Tasks, nil
+
+	if filter == nil {
+		return resp.Tasks, nil
+	}
+
+	tasks := filterTasks(resp.Tasks, *filter)
+
+	order, err := api.resolveTaskSort(*filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var names projectNameIndex
+	if order.Key == SortByProject {
+		projects, err := api.GetProjects()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project names for sorting: %w", err)
+		}
+		names = projectNames(projects)
+	}
+	sortTasks(tasks, order, names)
+
+	return tasks, nil
+}
+
+// resolveTaskSort picks the explicit sort, then the project's own sort order, then task ID
+func (api *API) resolveTaskSort(filter TaskFilter) (TaskSort, error) {
+	if filter.Sort.Key != "" {
+		return filter.Sort, nil
+	}
+
+	if filter.ProjectID != 0 {
+		project, err := api.GetProject(filter.ProjectID)
+		if err != nil {
+			return TaskSort{}, fmt.Errorf("failed to get sort order for project %d: %w", filter.ProjectID, err)
+		}
+		// An unrecognised project sort order is not worth failing the listing over
+		if order, err := ParseTaskSort(project.TaskSortOrder); err == nil && order.Key != "" {
+			return order, nil
+		}
+	}
+
+	return TaskSort{Key: sortByID}, nil
 }
 
 func (api *API) GetTask(id int) (*Task, error) {
@@ -199,7 +524,20 @@ func (api *API) GetTask(id int) (*Task, error) {
 	return &task, nil
 }
 
+func (api *API) GetSubtasks(parentID int) ([]Task, error) {
+	var tasks []Task
+	endpoint := "/api/task/" + strconv.Itoa(parentID) + "/subtasks"
+	if err := api.doGet(endpoint, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to get subtasks: %w", err)
+	}
+	return tasks, nil
+}
+
 func (api *API) CreateTask(req CreateTaskRequest) (*Task, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
 	var task Task
 	if err := api.doPost("/api/task", req, &task); err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
@@ -207,9 +545,42 @@ func (api *API) CreateTask(req CreateTaskRequest) (*Task, error) {
 	return &task, nil
 }
 
+// CreateSubtasks creates one subtask per name under the given parent, inheriting its project.
+// Creation stops at the first failure; subtasks created before it are returned with the error.
+func (api *API) CreateSubtasks(parentID int, names []string) ([]Task, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one subtask is required")
+	}
+	for i, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("subtask %d has an empty name", i+1)
+		}
+	}
+
+	parent, err := api.GetTask(parentID)
+	if err != nil {
+		return nil, fmt.Errorf("parent task with id %d not found: %w", parentID, err)
+	}
+
+	created := make([]Task, 0, len(names))
+	for _, name := range names {
+		task, err := api.CreateTask(CreateTaskRequest{
+			Name:         strings.TrimSpace(name),
+			ProjectID:    parent.ProjectID,
+			Status:       NotStarted,
+			ParentTaskID: parent.ID,
+		})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, *task)
+	}
+	return created, nil
+}
+
 func (api *API) UpdateTask(id int, req UpdateTaskRequest) (*Task, error) {
-	if req.Name == "" && req.Note == "" {
-		return nil, fmt.Errorf("no fields to update")
+	if err := req.validate(id); err != nil {
+		return nil, err
 	}
 
 	currentTask, err := api.GetTask(id)
@@ -217,17 +588,68 @@ func (api *API) UpdateTask(id int, req UpdateTaskRequest) (*Task, error) {
 		return nil, fmt.Errorf("task with id %d not found: %w", id, err)
 	}
 
-	currentTask.Name = req.Name
-	currentTask.Note = req.Note
+	req.apply(currentTask)
 
+	return api.patchTask(currentTask, req.clears())
+}
+
+// SetTaskStatus moves a task to the given status, e.g. Completed to finish it
+func (api *API) SetTaskStatus(id int, status Status) (*Task, error) {
+	return api.UpdateTask(id, UpdateTaskRequest{Status: &status})
+}
+
+// SetTaskToday adds a task to (true) or removes it from (false) the Today list
+func (api *API) SetTaskToday(id int, today bool) (*Task, error) {
+	return api.UpdateTask(id, UpdateTaskRequest{Today: &today})
+}
+
+// patchTask sends the full task back to Tudidi so fields we don't touch are preserved.
+// Cleared fields are sent as an explicit null, since omitted ones are left unchanged.
+func (api *API) patchTask(task *Task, clears taskClears) (*Task, error) {
 	var updatedTask Task
-	endpoint := "/api/task/" + strconv.Itoa(id)
-	if err := api.doPatch(endpoint, currentTask, &updatedTask); err != nil {
+	endpoint := "/api/task/" + strconv.Itoa(task.ID)
+	if err := api.doPatch(endpoint, taskPatchBody(task, clears), &updatedTask); err != nil {
 		return nil, fmt.Errorf("failed to update task: %w", err)
 	}
 	return &updatedTask, nil
 }
 
+// taskClears marks the task fields a patch empties
+type taskClears struct {
+	DueDate    bool
+	Project    bool
+	ParentTask bool
+}
+
+// taskPatch overrides the Task fields a patch may clear; like Task, it omits them when empty
+type taskPatch struct {
+	*Task
+	DueDate      any `json:"due_date,omitempty"`
+	ProjectID    any `json:"project_id,omitempty"`
+	ParentTaskID any `json:"parent_task_id,omitempty"`
+}
+
+func taskPatchBody(task *Task, clears taskClears) taskPatch {
+	return taskPatch{
+		Task:         task,
+		DueDate:      patchField(task.DueDate, clears.DueDate),
+		ProjectID:    patchField(task.ProjectID, clears.Project),
+		ParentTaskID: patchField(task.ParentTaskID, clears.ParentTask),
+	}
+}
+
+// patchField is null when clearing, omitted when empty, and the value otherwise
+func patchField[T comparable](value T, clear bool) any {
+	var zero T
+	switch {
+	case clear:
+		return json.RawMessage("null")
+	case value == zero:
+		return nil
+	}
+	return value
+}
+
 func (api *API) DeleteTask(id int) error {
 	endpoint := "/api/task/" + strconv.Itoa(id)
 	if err := api.doDelete(endpoint); err != nil {
@@ -248,6 +670,63 @@ func (api *API) GetProjects() ([]Project, error) {
 	return resp.Projects, nil
 }
 
+func (api *API) GetProject(id int) (*Project, error) {
+	var project Project
+	endpoint := "/api/project/" + strconv.Itoa(id)
+	if err := api.doGet(endpoint, &project); err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return &project, nil
+}
+
+func (api *API) CreateProject(req CreateProjectRequest) (*Project, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("project name cannot be empty")
+	}
+	if req.Priority != "" {
+		priority, err := ParsePriority(string(req.Priority))
+		if err != nil {
+			return nil, err
+		}
+		req.Priority = priority
+	}
+
+	var project Project
+	if err := api.doPost("/api/project", req, &project); err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+	return &project, nil
+}
+
+func (api *API) UpdateProject(id int, req UpdateProjectRequest) (*Project, error) {
+	if req.isEmpty() {
+		return nil, fmt.Errorf("no fields to update")
+	}
+	if req.Priority != "" {
+		priority, err := ParsePriority(string(req.Priority))
+		if err != nil {
+			return nil, err
+		}
+		req.Priority = priority
+	}
+
+	var project Project
+	endpoint := "/api/project/" + strconv.Itoa(id)
+	if err := api.doPatch(endpoint, req, &project); err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+	return &project, nil
+}
+
+func (api *API) DeleteProject(id int) error {
+	endpoint := "/api/project/" + strconv.Itoa(id)
+	if err := api.doDelete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	return nil
+}
+
 func (api *API) SearchProjectsByName(name string) ([]Project, error) {
 	if name == "" {
 		return nil, fmt.Errorf("name cannot be empty")
@@ -3,6 +3,8 @@ package tudidi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -158,6 +160,19 @@ func TestHandleResponse(t *testing.T) {
 	}
 }
 
+func TestHandleResponseNotFoundIsErrNotFound(t *testing.T) {
+	api := &API{}
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+
+	err := fmt.Errorf("failed to get task: %w", api.handleResponse(resp, &Task{}, http.StatusOK))
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected wrapped ErrNotFound, got %v", err)
+	}
+}
+
 func TestDoMutatingRequest_ReadonlyMode(t *testing.T) {
 	api := &API{readonly: true}
 
@@ -260,6 +275,18 @@ func BenchmarkHandleResponse(b *testing.B) {
 	}
 }
 
+func stringPtr(v string) *string {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
 // Test helper to create test responses
 func createJSONResponse(statusCode int, data interface{}) *http.Response {
 	jsonData, _ := json.Marshal(data)
@@ -330,3 +357,507 @@ func TestCreateJSONResponse_Helper(t *testing.T) {
 		t.Errorf("Expected task %+v, got %+v", task, parsedTask)
 	}
 }
+
+func TestTaskTagsDecoding(t *testing.T) {
+	api := &API{readonly: false}
+
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"id": 1, "name": "Call mum", "tags": [{"id": 3, "name": "@calls"}, {"id": 4, "name": "@home"}]}`)),
+	}
+
+	var task Task
+	if err := api.handleResponse(resp, &task, http.StatusOK); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(task.Tags) != 2 {
+		t.Fatalf("Expected 2 tags, got %d", len(task.Tags))
+	}
+	if task.Tags[0].ID != 3 || task.Tags[0].Name != "@calls" {
+		t.Errorf("Expected first tag {3 @calls}, got %+v", task.Tags[0])
+	}
+
+	body, err := json.Marshal(task)
+	if err != nil {
+		t.Fatalf("Failed to marshal task: %v", err)
+	}
+	if !strings.Contains(string(body), `"name":"@home"`) {
+		t.Errorf("Expected tags to survive re-encoding, got %s", body)
+	}
+}
+
+func TestTaskHasTag(t *testing.T) {
+	task := Task{Tags: []Tag{{Name: "@home"}, {Name: "@calls"}}}
+
+	if !task.HasTag("@home") {
+		t.Error("Expected task to have tag @home")
+	}
+	if !task.HasTag("@CALLS") {
+		t.Error("Expected tag matching to be case-insensitive")
+	}
+	if task.HasTag("@office") {
+		t.Error("Expected task not to have tag @office")
+	}
+}
+
+func TestTagValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"CreateTag", func() error { _, err := api.CreateTag("  "); return err }},
+		{"RenameTag", func() error { _, err := api.RenameTag(1, ""); return err }},
+		{"TagTask", func() error { _, err := api.TagTask(1, ""); return err }},
+		{"UntagTask", func() error { _, err := api.UntagTask(1, " "); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if err == nil {
+				t.Fatal("Expected validation error, got nil")
+			}
+			if !strings.Contains(err.Error(), "tag name cannot be empty") {
+				t.Errorf("Expected empty tag name error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestAreaValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.CreateArea(AreaRequest{Name: "   "})
+	if err == nil || !strings.Contains(err.Error(), "area name cannot be empty") {
+		t.Errorf("Expected empty area name error, got: %v", err)
+	}
+
+	_, err = api.UpdateArea(1, AreaRequest{})
+	if err == nil || !strings.Contains(err.Error(), "no fields to update") {
+		t.Errorf("Expected no fields error, got: %v", err)
+	}
+}
+
+func TestParsePriority(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    Priority
+		expectError bool
+	}{
+		{"low", PriorityLow, false},
+		{"Medium", PriorityMedium, false},
+		{" HIGH ", PriorityHigh, false},
+		{"urgent", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			priority, err := ParsePriority(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for '%s', got nil", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if priority != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, priority)
+			}
+		})
+	}
+}
+
+func TestProjectValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.CreateProject(CreateProjectRequest{Name: ""})
+	if err == nil || !strings.Contains(err.Error(), "project name cannot be empty") {
+		t.Errorf("Expected empty project name error, got: %v", err)
+	}
+
+	_, err = api.CreateProject(CreateProjectRequest{Name: "Launch", Priority: "urgent"})
+	if err == nil || !strings.Contains(err.Error(), "invalid priority") {
+		t.Errorf("Expected invalid priority error, got: %v", err)
+	}
+
+	_, err = api.UpdateProject(1, UpdateProjectRequest{})
+	if err == nil || !strings.Contains(err.Error(), "no fields to update") {
+		t.Errorf("Expected no fields error, got: %v", err)
+	}
+}
+
+func TestProjectMutationsReadonly(t *testing.T) {
+	api := &API{readonly: true}
+	archived := false
+
+	_, err := api.CreateProject(CreateProjectRequest{Name: "Launch"})
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on create, got: %v", err)
+	}
+
+	_, err = api.UpdateProject(1, UpdateProjectRequest{Active: &archived})
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on update, got: %v", err)
+	}
+
+	err = api.DeleteProject(1)
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on delete, got: %v", err)
+	}
+}
+
+func TestUpdateProjectRequestOmitsUnsetFields(t *testing.T) {
+	archived := false
+	body, err := json.Marshal(UpdateProjectRequest{Active: &archived})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	if string(body) != `{"active":false}` {
+		t.Errorf("Expected only active to be sent, got %s", body)
+	}
+}
+
+func TestNoteValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.CreateNote(CreateNoteRequest{Title: " "})
+	if err == nil || !strings.Contains(err.Error(), "note title cannot be empty") {
+		t.Errorf("Expected empty title error, got: %v", err)
+	}
+
+	_, err = api.UpdateNote(1, UpdateNoteRequest{})
+	if err == nil || !strings.Contains(err.Error(), "no fields to update") {
+		t.Errorf("Expected no fields error, got: %v", err)
+	}
+
+	_, err = api.AppendToNote(1, "\n")
+	if err == nil || !strings.Contains(err.Error(), "text to append cannot be empty") {
+		t.Errorf("Expected empty text error, got: %v", err)
+	}
+
+	_, err = api.SearchNotes("")
+	if err == nil || !strings.Contains(err.Error(), "query cannot be empty") {
+		t.Errorf("Expected empty query error, got: %v", err)
+	}
+}
+
+func TestInboxValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.CreateInboxItem("  ")
+	if err == nil || !strings.Contains(err.Error(), "inbox item content cannot be empty") {
+		t.Errorf("Expected empty content error, got: %v", err)
+	}
+}
+
+func TestInboxMutationsReadonly(t *testing.T) {
+	api := &API{readonly: true}
+
+	_, err := api.CreateInboxItem("Buy milk")
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on create, got: %v", err)
+	}
+
+	err = api.MarkInboxItemProcessed(1)
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on process, got: %v", err)
+	}
+
+	err = api.DeleteInboxItem(1)
+	if err == nil || !strings.Contains(err.Error(), "readonly mode") {
+		t.Errorf("Expected readonly error on delete, got: %v", err)
+	}
+}
+
+func TestCreateSubtasksValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.CreateSubtasks(1, nil)
+	if err == nil || !strings.Contains(err.Error(), "at least one subtask is required") {
+		t.Errorf("Expected missing subtasks error, got: %v", err)
+	}
+
+	_, err = api.CreateSubtasks(1, []string{"Draft outline", " "})
+	if err == nil || !strings.Contains(err.Error(), "subtask 2 has an empty name") {
+		t.Errorf("Expected empty subtask name error, got: %v", err)
+	}
+}
+
+func TestStatusCodes(t *testing.T) {
+	tests := []struct {
+		status Status
+		code   int
+	}{
+		{NotStarted, 0},
+		{InProgress, 1},
+		{Completed, 2},
+		{Archived, 3},
+		{Waiting, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			code, err := tt.status.Code()
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if code != tt.code {
+				t.Errorf("Expected code %d, got %d", tt.code, code)
+			}
+
+			status, err := StatusFromCode(tt.code)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if status != tt.status {
+				t.Errorf("Expected status %s, got %s", tt.status, status)
+			}
+		})
+	}
+
+	if _, err := Status("done").Code(); err == nil {
+		t.Error("Expected error for unknown status name")
+	}
+	if _, err := StatusFromCode(42); err == nil {
+		t.Error("Expected error for unknown status code")
+	}
+}
+
+func TestSetTaskStatusValidation(t *testing.T) {
+	api := &API{readonly: false}
+
+	_, err := api.SetTaskStatus(1, Status("done"))
+	if err == nil || !strings.Contains(err.Error(), "invalid status") {
+		t.Errorf("Expected invalid status error, got: %v", err)
+	}
+}
+
+func TestTaskStatusAlwaysEncoded(t *testing.T) {
+	body, err := json.Marshal(Task{ID: 1, Name: "Reopened", Status: 0})
+	if err != nil {
+		t.Fatalf("Failed to marshal task: %v", err)
+	}
+
+	if !strings.Contains(string(body), `"status":0`) {
+		t.Errorf("Expected not_started status to be sent explicitly, got %s", body)
+	}
+}
+
+func TestPriorityCodes(t *testing.T) {
+	for priority, code := range map[Priority]int{PriorityLow: 0, PriorityMedium: 1, PriorityHigh: 2} {
+		got, err := priority.Code()
+		if err != nil || got != code {
+			t.Errorf("Expected %s to map to %d, got %d (%v)", priority, code, got, err)
+		}
+		back, err := PriorityFromCode(code)
+		if err != nil || back != priority {
+			t.Errorf("Expected %d to map back to %s, got %s (%v)", code, priority, back, err)
+		}
+	}
+}
+
+func TestUpdateTaskRequestValidation(t *testing.T) {
+	badPriority := Priority("urgent")
+
+	tests := []struct {
+		name          string
+		req           UpdateTaskRequest
+		errorContains string
+	}{
+		{"No fields", UpdateTaskRequest{}, "no fields to update"},
+		{"Empty name", UpdateTaskRequest{Name: stringPtr(" ")}, "task name cannot be empty"},
+		{"Bad priority", UpdateTaskRequest{Priority: &badPriority}, "invalid priority"},
+		{"Bad due date", UpdateTaskRequest{DueDate: stringPtr("tomorrow")}, "invalid due date"},
+		{"Bad project", UpdateTaskRequest{ProjectID: intPtr(-1)}, "project ID cannot be negative"},
+		{"Bad parent", UpdateTaskRequest{ParentTaskID: intPtr(-1)}, "parent task ID cannot be negative"},
+		{"Own parent", UpdateTaskRequest{ParentTaskID: intPtr(7)}, "cannot be its own parent"},
+		{"Clear due date", UpdateTaskRequest{DueDate: stringPtr("")}, ""},
+		{"Clear project", UpdateTaskRequest{ProjectID: intPtr(0)}, ""},
+		{"Clear parent", UpdateTaskRequest{ParentTaskID: intPtr(0)}, ""},
+		{"Today only", UpdateTaskRequest{Today: boolPtr(false)}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate(7)
+			if tt.errorContains == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskRequestApplyPreservesUnsetFields(t *testing.T) {
+	high := PriorityHigh
+	task := Task{
+		ID:        7,
+		Name:      "Send invoice",
+		Note:      "Use the new template",
+		DueDate:   "2026-10-20",
+		Today:     true,
+		ProjectID: 3,
+	}
+
+	UpdateTaskRequest{Name: stringPtr("Send invoice to ACME"), Priority: &high}.apply(&task)
+
+	if task.Name != "Send invoice to ACME" {
+		t.Errorf("Expected name to be updated, got %s", task.Name)
+	}
+	if task.Priority != 2 {
+		t.Errorf("Expected priority code 2, got %d", task.Priority)
+	}
+	if task.Note != "Use the new template" || task.DueDate != "2026-10-20" || !task.Today || task.ProjectID != 3 {
+		t.Errorf("Expected unset fields to be preserved, got %+v", task)
+	}
+
+	UpdateTaskRequest{DueDate: stringPtr(""), Today: boolPtr(false), ProjectID: intPtr(5)}.apply(&task)
+
+	if task.DueDate != "" || task.Today || task.ProjectID != 5 {
+		t.Errorf("Expected due date cleared, today unset and project moved, got %+v", task)
+	}
+}
+
+func TestUpdateTaskRequestApplyReopen(t *testing.T) {
+	inProgress := Task{ID: 7, Status: 1}
+	UpdateTaskRequest{Reopen: true}.apply(&inProgress)
+	if inProgress.Status != 1 {
+		t.Errorf("Expected in-progress task to keep its status, got %d", inProgress.Status)
+	}
+
+	completed := Task{ID: 8, Status: 2, CompletedAt: "2026-10-15T08:00:00.000Z"}
+	UpdateTaskRequest{Reopen: true}.apply(&completed)
+	if completed.Status != 0 || completed.CompletedAt != "" {
+		t.Errorf("Expected completed task to be reopened with no completion time, got %+v", completed)
+	}
+
+	archived := Task{ID: 9, Status: 3, CompletedAt: "2026-10-15T08:00:00.000Z"}
+	UpdateTaskRequest{Reopen: true}.apply(&archived)
+	if archived.Status != 0 || archived.CompletedAt != "" {
+		t.Errorf("Expected archived task to be reopened with no completion time, got %+v", archived)
+	}
+}
+
+func TestTaskPatchBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		task        Task
+		clears      taskClears
+		contains    []string
+		notContains []string
+	}{
+		{
+			"Keeps set fields",
+			Task{ID: 7, Name: "Send invoice", DueDate: "2026-10-20", ProjectID: 3, ParentTaskID: 5},
+			taskClears{},
+			[]string{`"due_date":"2026-10-20"`, `"project_id":3`, `"parent_task_id":5`},
+			nil,
+		},
+		{
+			"Omits empty fields",
+			Task{ID: 7, Name: "Send invoice"},
+			taskClears{},
+			nil,
+			[]string{`"due_date"`, `"project_id"`, `"parent_task_id"`},
+		},
+		{
+			"Clears due date only",
+			Task{ID: 7, Name: "Send invoice", ProjectID: 3},
+			taskClears{DueDate: true},
+			[]string{`"due_date":null`, `"project_id":3`, `"name":"Send invoice"`},
+			[]string{`"parent_task_id"`},
+		},
+		{
+			"Clears project and parent",
+			Task{ID: 7, Name: "Send invoice", DueDate: "2026-10-20"},
+			taskClears{Project: true, ParentTask: true},
+			[]string{`"project_id":null`, `"parent_task_id":null`, `"due_date":"2026-10-20"`},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := json.Marshal(taskPatchBody(&tt.task, tt.clears))
+			if err != nil {
+				t.Fatalf("Failed to marshal patch: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(encoded), want) {
+					t.Errorf("Expected %s in %s", want, encoded)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(string(encoded), unwanted) {
+					t.Errorf("Expected no %s in %s", unwanted, encoded)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateTaskRequestJSON(t *testing.T) {
+	encoded, err := json.Marshal(CreateTaskRequest{Name: "Pay rent", Status: InProgress, Priority: PriorityLow})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatalf("Failed to decode request: %v", err)
+	}
+	if fields["status"] != float64(1) || fields["priority"] != float64(0) {
+		t.Errorf("Expected status 1 and priority 0 as codes, got %s", encoded)
+	}
+
+	encoded, err = json.Marshal(CreateTaskRequest{Name: "Pay rent"})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+	if strings.Contains(string(encoded), `"status"`) || strings.Contains(string(encoded), `"priority"`) {
+		t.Errorf("Expected unset status and priority to be omitted, got %s", encoded)
+	}
+}
+
+func TestCreateTaskRequestValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           CreateTaskRequest
+		errorContains string
+	}{
+		{"Valid", CreateTaskRequest{Name: "Pay rent", Status: NotStarted, Priority: PriorityHigh, DueDate: "2026-11-01", Today: true, Tags: []Tag{{Name: "@home"}}}, ""},
+		{"Empty name", CreateTaskRequest{Name: " "}, "task name cannot be empty"},
+		{"Bad status", CreateTaskRequest{Name: "Pay rent", Status: "done"}, "invalid status"},
+		{"Completed status", CreateTaskRequest{Name: "Pay rent", Status: Completed}, "new tasks cannot be completed"},
+		{"Archived status", CreateTaskRequest{Name: "Pay rent", Status: Archived}, "new tasks cannot be archived"},
+		{"Bad priority", CreateTaskRequest{Name: "Pay rent", Priority: "urgent"}, "invalid priority"},
+		{"Bad due date", CreateTaskRequest{Name: "Pay rent", DueDate: "01/11/2026"}, "invalid due date"},
+		{"Empty tag", CreateTaskRequest{Name: "Pay rent", Tags: []Tag{{Name: ""}}}, "tag name cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate()
+			if tt.errorContains == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+			}
+		})
+	}
+}
@@ -62,7 +62,7 @@ func TestNewAPI(t *testing.T) {
 func TestGetTasks(t *testing.T) {
 	api := setupTestAPI(t, false) // readonly doesn't matter for GET
 
-	tasks, err := api.GetTasks()
+	tasks, err := api.GetTasks(nil)
 	if err != nil {
 		t.Fatalf("Failed to get tasks: %v", err)
 	}
@@ -85,7 +85,7 @@ func TestGetTasks(t *testing.T) {
 func TestGetTasksReadonly(t *testing.T) {
 	api := setupTestAPI(t, true)
 
-	tasks, err := api.GetTasks()
+	tasks, err := api.GetTasks(nil)
 	if err != nil {
 		t.Fatalf("Failed to get tasks in readonly mode: %v", err)
 	}
@@ -182,8 +182,8 @@ func TestTaskCRUDOperations(t *testing.T) {
 
 	// Test Update Task
 	updateReq := UpdateTaskRequest{
-		Name: "Updated Test Task",
-		Note: "Updated description",
+		Name: stringPtr("Updated Test Task"),
+		Note: stringPtr("Updated description"),
 	}
 
 	updatedTask, err := api.UpdateTask(createdTask.ID, updateReq)
@@ -250,7 +250,7 @@ func TestReadonlyModeEnforcement(t *testing.T) {
 
 	// Test Update Task in readonly mode
 	updateReq := UpdateTaskRequest{
-		Name: "Should Not Be Updated",
+		Name: stringPtr("Should Not Be Updated"),
 	}
 
 	_, err = api.UpdateTask(1, updateReq)
@@ -275,7 +275,7 @@ func TestUpdateNonExistentTask(t *testing.T) {
 	api := setupTestAPI(t, false)
 
 	updateReq := UpdateTaskRequest{
-		Name: "Should Not Work",
+		Name: stringPtr("Should Not Work"),
 	}
 
 	// Try to update a task with a very high ID that likely doesn't exist
@@ -322,6 +322,319 @@ func TestCreateTaskValidation(t *testing.T) {
 	t.Logf("Expected error for invalid project ID: %v", err)
 }
 
+func TestTagCRUDOperations(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	tagName := fmt.Sprintf("test-tag-%d", time.Now().Unix())
+	createdTag, err := api.CreateTag(tagName)
+	if err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+	if createdTag.Name != tagName {
+		t.Errorf("Expected tag name %s, got %s", tagName, createdTag.Name)
+	}
+
+	tags, err := api.GetTags()
+	if err != nil {
+		t.Fatalf("Failed to get tags: %v", err)
+	}
+	found := false
+	for _, tag := range tags {
+		if tag.ID == createdTag.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Created tag %d not returned by GetTags", createdTag.ID)
+	}
+
+	renamedTag, err := api.RenameTag(createdTag.ID, tagName+"-renamed")
+	if err != nil {
+		t.Fatalf("Failed to rename tag: %v", err)
+	}
+	if renamedTag.Name != tagName+"-renamed" {
+		t.Errorf("Expected renamed tag %s-renamed, got %s", tagName, renamedTag.Name)
+	}
+
+	if err := api.DeleteTag(createdTag.ID); err != nil {
+		t.Fatalf("Failed to delete tag: %v", err)
+	}
+}
+
+func TestAreaCRUDOperations(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	createReq := AreaRequest{
+		Name:        fmt.Sprintf("Test Area %d", time.Now().Unix()),
+		Description: "Created by automated tests",
+	}
+
+	createdArea, err := api.CreateArea(createReq)
+	if err != nil {
+		t.Fatalf("Failed to create area: %v", err)
+	}
+	if createdArea.Name != createReq.Name {
+		t.Errorf("Expected area name %s, got %s", createReq.Name, createdArea.Name)
+	}
+
+	retrievedArea, err := api.GetArea(createdArea.ID)
+	if err != nil {
+		t.Fatalf("Failed to get area: %v", err)
+	}
+	if retrievedArea.ID != createdArea.ID {
+		t.Errorf("Expected area ID %d, got %d", createdArea.ID, retrievedArea.ID)
+	}
+
+	updatedArea, err := api.UpdateArea(createdArea.ID, AreaRequest{Name: "Updated Test Area"})
+	if err != nil {
+		t.Fatalf("Failed to update area: %v", err)
+	}
+	if updatedArea.Name != "Updated Test Area" {
+		t.Errorf("Expected area name 'Updated Test Area', got %s", updatedArea.Name)
+	}
+
+	if err := api.DeleteArea(createdArea.ID); err != nil {
+		t.Fatalf("Failed to delete area: %v", err)
+	}
+}
+
+func TestProjectCRUDOperations(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	createReq := CreateProjectRequest{
+		Name:     fmt.Sprintf("Test Project %d", time.Now().Unix()),
+		Active:   true,
+		Priority: PriorityMedium,
+	}
+
+	createdProject, err := api.CreateProject(createReq)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	if createdProject.Name != createReq.Name {
+		t.Errorf("Expected project name %s, got %s", createReq.Name, createdProject.Name)
+	}
+
+	retrievedProject, err := api.GetProject(createdProject.ID)
+	if err != nil {
+		t.Fatalf("Failed to get project: %v", err)
+	}
+	if retrievedProject.ID != createdProject.ID {
+		t.Errorf("Expected project ID %d, got %d", createdProject.ID, retrievedProject.ID)
+	}
+
+	archived := false
+	pinned := true
+	updatedProject, err := api.UpdateProject(createdProject.ID, UpdateProjectRequest{
+		Active:       &archived,
+		PinToSidebar: &pinned,
+		Priority:     PriorityHigh,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update project: %v", err)
+	}
+	if updatedProject.Active {
+		t.Error("Expected project to be archived")
+	}
+	if updatedProject.Name != createReq.Name {
+		t.Errorf("Expected name to be preserved as %s, got %s", createReq.Name, updatedProject.Name)
+	}
+
+	if err := api.DeleteProject(createdProject.ID); err != nil {
+		t.Fatalf("Failed to delete project: %v", err)
+	}
+}
+
+func TestNoteCRUDOperations(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	createReq := CreateNoteRequest{
+		Title:   fmt.Sprintf("Test Note %d", time.Now().Unix()),
+		Content: "Attendees: everyone",
+	}
+
+	createdNote, err := api.CreateNote(createReq)
+	if err != nil {
+		t.Fatalf("Failed to create note: %v", err)
+	}
+	if createdNote.Title != createReq.Title {
+		t.Errorf("Expected note title %s, got %s", createReq.Title, createdNote.Title)
+	}
+
+	appendedNote, err := api.AppendToNote(createdNote.ID, "Action items: none")
+	if err != nil {
+		t.Fatalf("Failed to append to note: %v", err)
+	}
+	if appendedNote.Content != "Attendees: everyone\n\nAction items: none" {
+		t.Errorf("Unexpected note content after append: %q", appendedNote.Content)
+	}
+
+	found, err := api.SearchNotes("action items")
+	if err != nil {
+		t.Fatalf("Failed to search notes: %v", err)
+	}
+	if len(found) == 0 {
+		t.Error("Expected search to find the created note")
+	}
+
+	if err := api.DeleteNote(createdNote.ID); err != nil {
+		t.Fatalf("Failed to delete note: %v", err)
+	}
+}
+
+func TestInboxProcessing(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	projects, err := api.GetProjects()
+	if err != nil {
+		t.Fatalf("Failed to get projects: %v", err)
+	}
+	if len(projects) == 0 {
+		t.Skip("No projects available for testing - cannot process inbox items into tasks")
+	}
+
+	item, err := api.CreateInboxItem(fmt.Sprintf("Inbox capture %d", time.Now().Unix()))
+	if err != nil {
+		t.Fatalf("Failed to create inbox item: %v", err)
+	}
+
+	task, err := api.ProcessInboxItemAsTask(item.ID, CreateTaskRequest{
+		ProjectID: projects[0].ID,
+		Priority:  PriorityHigh,
+	})
+	if err != nil {
+		t.Fatalf("Failed to process inbox item: %v", err)
+	}
+	if task.Name != item.Content {
+		t.Errorf("Expected task name %s, got %s", item.Content, task.Name)
+	}
+
+	items, err := api.GetInboxItems()
+	if err != nil {
+		t.Fatalf("Failed to get inbox items: %v", err)
+	}
+	for _, remaining := range items {
+		if remaining.ID == item.ID {
+			t.Errorf("Expected inbox item %d to be processed", item.ID)
+		}
+	}
+
+	if err := api.DeleteTask(task.ID); err != nil {
+		t.Fatalf("Failed to delete task: %v", err)
+	}
+}
+
+func TestSubtasks(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	projects, err := api.GetProjects()
+	if err != nil {
+		t.Fatalf("Failed to get projects: %v", err)
+	}
+	if len(projects) == 0 {
+		t.Skip("No projects available for testing - cannot create tasks without a project")
+	}
+
+	parent, err := api.CreateTask(CreateTaskRequest{
+		Name:      fmt.Sprintf("Test Parent Task %d", time.Now().Unix()),
+		ProjectID: projects[0].ID,
+		Status:    NotStarted,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create parent task: %v", err)
+	}
+	defer api.DeleteTask(parent.ID)
+
+	created, err := api.CreateSubtasks(parent.ID, []string{"Step one", "Step two"})
+	for _, subtask := range created {
+		defer api.DeleteTask(subtask.ID)
+	}
+	if err != nil {
+		t.Fatalf("Failed to create subtasks: %v", err)
+	}
+
+	subtasks, err := api.GetSubtasks(parent.ID)
+	if err != nil {
+		t.Fatalf("Failed to get subtasks: %v", err)
+	}
+	if len(subtasks) != 2 {
+		t.Errorf("Expected 2 subtasks, got %d", len(subtasks))
+	}
+	for _, subtask := range subtasks {
+		if subtask.ParentTaskID != parent.ID {
+			t.Errorf("Expected parent ID %d, got %d", parent.ID, subtask.ParentTaskID)
+		}
+	}
+}
+
+func TestTaskStatusTransitions(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	projects, err := api.GetProjects()
+	if err != nil {
+		t.Fatalf("Failed to get projects: %v", err)
+	}
+	if len(projects) == 0 {
+		t.Skip("No projects available for testing - cannot create tasks without a project")
+	}
+
+	task, err := api.CreateTask(CreateTaskRequest{
+		Name:      fmt.Sprintf("Test Status Task %d", time.Now().Unix()),
+		ProjectID: projects[0].ID,
+		Status:    NotStarted,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	defer api.DeleteTask(task.ID)
+
+	for _, status := range []Status{InProgress, Completed, NotStarted} {
+		updated, err := api.SetTaskStatus(task.ID, status)
+		if err != nil {
+			t.Fatalf("Failed to set status %s: %v", status, err)
+		}
+		expected, _ := status.Code()
+		if updated.Status != expected {
+			t.Errorf("Expected status code %d after %s, got %d", expected, status, updated.Status)
+		}
+		if updated.Name != task.Name {
+			t.Errorf("Expected name to be preserved as %s, got %s", task.Name, updated.Name)
+		}
+	}
+}
+
+func TestTodayList(t *testing.T) {
+	api := setupTestAPI(t, false)
+
+	projects, err := api.GetProjects()
+	if err != nil {
+		t.Fatalf("Failed to get projects: %v", err)
+	}
+	if len(projects) == 0 {
+		t.Skip("No projects available for testing - cannot create tasks without a project")
+	}
+
+	task, err := api.CreateTask(CreateTaskRequest{
+		Name:      fmt.Sprintf("Test Today Task %d", time.Now().Unix()),
+		ProjectID: projects[0].ID,
+		Status:    NotStarted,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	defer api.DeleteTask(task.ID)
+
+	for _, today := range []bool{true, false} {
+		updated, err := api.SetTaskToday(task.ID, today)
+		if err != nil {
+			t.Fatalf("Failed to set today to %v: %v", today, err)
+		}
+		if updated.Today != today {
+			t.Errorf("Expected today to be %v, got %v", today, updated.Today)
+		}
+	}
+}
+
 // Benchmark tests for performance
 func BenchmarkGetTasks(b *testing.B) {
 	if testURL == "" || testEmail == "" || testPassword == "" {
@@ -342,7 +655,7 @@ func BenchmarkGetTasks(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := api.GetTasks()
+		_, err := api.GetTasks(nil)
 		if err != nil {
 			b.Fatalf("Failed to get tasks: %v", err)
 		}
@@ -0,0 +1,74 @@
+package tudidi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Area struct {
+	ID          int    `json:"id"`
+	UUID        string `json:"uuid,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	UserID      int    `json:"user_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+type AreaRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (api *API) GetAreas() ([]Area, error) {
+	var areas []Area
+	if err := api.doGet("/api/areas", &areas); err != nil {
+		return nil, fmt.Errorf("failed to get areas: %w", err)
+	}
+	return areas, nil
+}
+
+func (api *API) GetArea(id int) (*Area, error) {
+	var area Area
+	endpoint := "/api/areas/" + strconv.Itoa(id)
+	if err := api.doGet(endpoint, &area); err != nil {
+		return nil, fmt.Errorf("failed to get area: %w", err)
+	}
+	return &area, nil
+}
+
+func (api *API) CreateArea(req AreaRequest) (*Area, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("area name cannot be empty")
+	}
+
+	var area Area
+	if err := api.doPost("/api/areas", req, &area); err != nil {
+		return nil, fmt.Errorf("failed to create area: %w", err)
+	}
+	return &area, nil
+}
+
+func (api *API) UpdateArea(id int, req AreaRequest) (*Area, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" && req.Description == "" {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	var area Area
+	endpoint := "/api/areas/" + strconv.Itoa(id)
+	if err := api.doPatch(endpoint, req, &area); err != nil {
+		return nil, fmt.Errorf("failed to update area: %w", err)
+	}
+	return &area, nil
+}
+
+func (api *API) DeleteArea(id int) error {
+	endpoint := "/api/areas/" + strconv.Itoa(id)
+	if err := api.doDelete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete area: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package tudidi
+
+import "sort"
+
+// Snapshot records when each task and project was last updated, so two polls can be compared
+type Snapshot struct {
+	tasks    map[int]taskState
+	projects map[int]string
+}
+
+type taskState struct {
+	updatedAt string
+	projectID int
+	parentID  int
+}
+
+// Changes lists what differs between two snapshots
+type Changes struct {
+	// Tasks holds tasks that were added, updated or deleted, plus the parents of changed subtasks
+	Tasks []int
+	// Projects holds projects that were updated or deleted, plus projects whose tasks changed
+	Projects []int
+	// ProjectListChanged reports whether any project was added, updated or deleted
+	ProjectListChanged bool
+}
+
+// Empty reports whether nothing changed
+func (c Changes) Empty() bool {
+	return len(c.Tasks) == 0 && len(c.Projects) == 0 && !c.ProjectListChanged
+}
+
+// TakeSnapshot records the UpdatedAt timestamps of tasks and projects
+func TakeSnapshot(tasks []Task, projects []Project) *Snapshot {
+	snapshot := &Snapshot{
+		tasks:    make(map[int]taskState, len(tasks)),
+		projects: make(map[int]string, len(projects)),
+	}
+	for _, task := range tasks {
+		snapshot.tasks[task.ID] = taskState{
+			updatedAt: task.UpdatedAt,
+			projectID: task.ProjectID,
+			parentID:  task.ParentTaskID,
+		}
+	}
+	for _, project := range projects {
+		snapshot.projects[project.ID] = project.UpdatedAt
+	}
+	return snapshot
+}
+
+// Diff compares the snapshot with a newer one. IDs in the result are sorted.
+func (s *Snapshot) Diff(next *Snapshot) Changes {
+	tasks := make(map[int]bool)
+	projects := make(map[int]bool)
+	var changes Changes
+
+	taskChanged := func(id int, state taskState) {
+		tasks[id] = true
+		if state.parentID != 0 {
+			tasks[state.parentID] = true
+		}
+		if state.projectID != 0 {
+			projects[state.projectID] = true
+		}
+	}
+
+	for id, old := range s.tasks {
+		current, ok := next.tasks[id]
+		if !ok {
+			taskChanged(id, old)
+			continue
+		}
+		if current != old {
+			// A moved task changes both its old and new project and parent
+			taskChanged(id, old)
+			taskChanged(id, current)
+		}
+	}
+	for id, current := range next.tasks {
+		if _, ok := s.tasks[id]; !ok {
+			taskChanged(id, current)
+		}
+	}
+
+	for id, old := range s.projects {
+		current, ok := next.projects[id]
+		if !ok || current != old {
+			projects[id] = true
+			changes.ProjectListChanged = true
+		}
+	}
+	for id := range next.projects {
+		if _, ok := s.projects[id]; !ok {
+			changes.ProjectListChanged = true
+		}
+	}
+
+	changes.Tasks = sortedIDs(tasks)
+	changes.Projects = sortedIDs(projects)
+	return changes
+}
+
+func sortedIDs(set map[int]bool) []int {
+	if len(set) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,72 @@
+package tudidi
+
+import "testing"
+
+func TestSnapshotDiff(t *testing.T) {
+	projects := []Project{
+		{ID: 10, Name: "Work", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 20, Name: "Home", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+	}
+	tasks := []Task{
+		{ID: 1, Name: "Unchanged", ProjectID: 10, UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 2, Name: "Completed", ProjectID: 10, UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 3, Name: "Deleted", ProjectID: 20, UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 4, Name: "Parent", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 5, Name: "Subtask", ParentTaskID: 4, UpdatedAt: "2026-10-01T08:00:00.000Z"},
+	}
+	before := TakeSnapshot(tasks, projects)
+
+	if changes := before.Diff(TakeSnapshot(tasks, projects)); !changes.Empty() {
+		t.Errorf("Expected no changes between identical snapshots, got %+v", changes)
+	}
+
+	tasks = []Task{
+		tasks[0],
+		{ID: 2, Name: "Completed", ProjectID: 10, Status: 2, UpdatedAt: "2026-10-16T08:00:00.000Z"},
+		tasks[3],
+		{ID: 5, Name: "Subtask", ParentTaskID: 4, UpdatedAt: "2026-10-16T08:00:00.000Z"},
+		{ID: 6, Name: "New", ProjectID: 20, UpdatedAt: "2026-10-16T08:00:00.000Z"},
+	}
+	changes := before.Diff(TakeSnapshot(tasks, projects))
+
+	if !equalIDs(changes.Tasks, []int{2, 3, 4, 5, 6}) {
+		t.Errorf("Expected changed tasks [2 3 4 5 6], got %v", changes.Tasks)
+	}
+	if !equalIDs(changes.Projects, []int{10, 20}) {
+		t.Errorf("Expected changed projects [10 20], got %v", changes.Projects)
+	}
+	if changes.ProjectListChanged {
+		t.Error("Expected project list to be unchanged when only tasks changed")
+	}
+}
+
+func TestSnapshotDiffProjects(t *testing.T) {
+	before := TakeSnapshot(nil, []Project{
+		{ID: 10, Name: "Work", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 20, Name: "Home", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+	})
+
+	renamed := before.Diff(TakeSnapshot(nil, []Project{
+		{ID: 10, Name: "Job", UpdatedAt: "2026-10-16T08:00:00.000Z"},
+		{ID: 20, Name: "Home", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+	}))
+	if !renamed.ProjectListChanged || !equalIDs(renamed.Projects, []int{10}) {
+		t.Errorf("Expected project 10 to change, got %+v", renamed)
+	}
+
+	added := before.Diff(TakeSnapshot(nil, []Project{
+		{ID: 10, Name: "Work", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 20, Name: "Home", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 30, Name: "Garden", UpdatedAt: "2026-10-16T08:00:00.000Z"},
+	}))
+	if !added.ProjectListChanged || len(added.Projects) != 0 {
+		t.Errorf("Expected only the project list to change when a project is added, got %+v", added)
+	}
+
+	deleted := before.Diff(TakeSnapshot(nil, []Project{
+		{ID: 10, Name: "Work", UpdatedAt: "2026-10-01T08:00:00.000Z"},
+	}))
+	if !deleted.ProjectListChanged || !equalIDs(deleted.Projects, []int{20}) {
+		t.Errorf("Expected project 20 to change when deleted, got %+v", deleted)
+	}
+}
@@ -0,0 +1,120 @@
+package tudidi
+
+import (
+	"sort"
+	"strings"
+)
+
+// CompletionCandidate is a value that can be suggested for an argument, with the
+// text it is matched against (e.g. a project ID suggested by its name)
+type CompletionCandidate struct {
+	Value string
+	Text  string
+}
+
+// Complete returns the values of the candidates matching query, best matches
+// first. Exact matches rank above prefixes, then word prefixes ("home reno"
+// for "Home Renovation"), substrings, typos and finally letters in order
+// ("hrn" for "Home Renovation"). Candidates that rank equally keep their
+// order, and an empty query returns every candidate.
+func Complete(query string, candidates []CompletionCandidate) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	type ranked struct {
+		value string
+		rank  int
+	}
+	var matches []ranked
+	seen := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		if seen[candidate.Value] {
+			continue
+		}
+		rank, ok := completionRank(query, candidate)
+		if !ok {
+			continue
+		}
+		seen[candidate.Value] = true
+		matches = append(matches, ranked{value: candidate.Value, rank: rank})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].rank < matches[j].rank
+	})
+
+	values := make([]string, len(matches))
+	for i, match := range matches {
+		values[i] = match.value
+	}
+	return values
+}
+
+// completionRank scores how well query matches a candidate; lower is better
+func completionRank(query string, candidate CompletionCandidate) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	value := strings.ToLower(candidate.Value)
+	text := strings.ToLower(candidate.Text)
+	switch {
+	case value == query || text == query:
+		return 0, true
+	case strings.HasPrefix(value, query) || strings.HasPrefix(text, query):
+		return 1, true
+	case wordsPrefixMatch(splitWords(query), splitWords(text)):
+		return 2, true
+	case strings.Contains(text, query):
+		return 3, true
+	case typoPrefixMatch(query, text):
+		return 4, true
+	case isSubsequence(query, text):
+		return 5, true
+	}
+	return 0, false
+}
+
+// typoPrefixMatch reports whether text starts with query give or take a few typos
+func typoPrefixMatch(query, text string) bool {
+	queryRunes := []rune(query)
+	if len(queryRunes) < 3 {
+		return false
+	}
+	textRunes := []rune(text)
+	if len(textRunes) > len(queryRunes) {
+		textRunes = textRunes[:len(queryRunes)]
+	}
+	return levenshtein(query, string(textRunes)) <= max(1, len(queryRunes)/4)
+}
+
+// isSubsequence reports whether the letters of query appear in text in order
+func isSubsequence(query, text string) bool {
+	queryRunes := []rune(query)
+	if len(queryRunes) < 2 {
+		return false
+	}
+	next := 0
+	for _, r := range text {
+		if r == queryRunes[next] {
+			next++
+			if next == len(queryRunes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RecentTasks returns the open tasks, most recently updated first
+func RecentTasks(tasks []Task) []Task {
+	var open []Task
+	for _, task := range tasks {
+		if !task.IsClosed() {
+			open = append(open, task)
+		}
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		return lastUpdated(open[i]).After(lastUpdated(open[j]))
+	})
+	return open
+}
@@ -0,0 +1,67 @@
+package tudidi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	candidates := []CompletionCandidate{
+		{Value: "Home Renovation", Text: "Home Renovation"},
+		{Value: "Work", Text: "Work"},
+		{Value: "Homework", Text: "Homework"},
+		{Value: "Garden", Text: "Garden"},
+		{Value: "Work", Text: "Work"},
+	}
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"Home Renovation", "Work", "Homework", "Garden"}},
+		{"work", []string{"Work", "Homework"}},
+		{"HOME", []string{"Home Renovation", "Homework"}},
+		{"home reno", []string{"Home Renovation"}},
+		{"reno", []string{"Home Renovation"}},
+		{"gardn", []string{"Garden"}},
+		{"hrn", []string{"Home Renovation"}},
+		{"xyz", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			result := Complete(tt.query, candidates)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Complete(%q) = %v, expected %v", tt.query, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompleteMatchesValueOrText(t *testing.T) {
+	candidates := []CompletionCandidate{
+		{Value: "12", Text: "Write report"},
+		{Value: "3", Text: "Review 12 pull requests"},
+		{Value: "120", Text: "Plan offsite"},
+	}
+
+	if result := Complete("12", candidates); !reflect.DeepEqual(result, []string{"12", "120", "3"}) {
+		t.Errorf("Expected ID matches before name matches, got %v", result)
+	}
+	if result := Complete("report", candidates); !reflect.DeepEqual(result, []string{"12"}) {
+		t.Errorf("Expected the ID of the task named 'Write report', got %v", result)
+	}
+}
+
+func TestRecentTasks(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, UpdatedAt: "2026-10-01T08:00:00.000Z"},
+		{ID: 2, Status: 2, UpdatedAt: "2026-10-16T08:00:00.000Z"},
+		{ID: 3, UpdatedAt: "2026-10-15T08:00:00.000Z"},
+		{ID: 4, CreatedAt: "2026-10-10T08:00:00.000Z"},
+	}
+
+	if ids := taskIDs(RecentTasks(tasks)); !equalIDs(ids, []int{3, 4, 1}) {
+		t.Errorf("Expected open tasks [3 4 1], got %v", ids)
+	}
+}
@@ -0,0 +1,136 @@
+package tudidi
+
+import (
+	"sort"
+	"strconv"
+)
+
+// DueReport groups open tasks due within a date range by day and, within each day, by project
+type DueReport struct {
+	From     string         `json:"from,omitempty"`
+	To       string         `json:"to"`
+	Count    int            `json:"count"`
+	Days     []DueDay       `json:"days"`
+	Projects []ProjectCount `json:"projects"`
+}
+
+// DueDay holds the tasks due on one date
+type DueDay struct {
+	Date     string         `json:"date"`
+	Count    int            `json:"count"`
+	Projects []ProjectTasks `json:"projects"`
+}
+
+// ProjectTasks holds the tasks of one project within a group; ProjectID 0 means no project
+type ProjectTasks struct {
+	ProjectID   int    `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Count       int    `json:"count"`
+	Tasks       []Task `json:"tasks"`
+}
+
+// ProjectCount is the number of tasks a project has across a whole report
+type ProjectCount struct {
+	ProjectID   int    `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Count       int    `json:"count"`
+}
+
+// NoProjectName labels tasks that don't belong to a project
+const NoProjectName = "No Project"
+
+// BuildDueReport collects the open tasks due between from and to (YYYY-MM-DD,
+// inclusive; an empty from means no lower bound). Days are in date order,
+// projects within a day and in the totals by name, tasks by priority.
+func BuildDueReport(tasks []Task, projects []Project, from, to string) DueReport {
+	report := DueReport{From: from, To: to}
+	names := projectNames(projects)
+
+	byDay := make(map[string][]Task)
+	totals := make(map[int]int)
+	for _, task := range tasks {
+		due := DateOnly(task.DueDate)
+		if task.IsClosed() || due == "" || due > to || (from != "" && due < from) {
+			continue
+		}
+		byDay[due] = append(byDay[due], task)
+		totals[task.ProjectID]++
+		report.Count++
+	}
+
+	for date, dayTasks := range byDay {
+		day := DueDay{Date: date, Count: len(dayTasks), Projects: groupByProject(dayTasks, names)}
+		for _, group := range day.Projects {
+			SortTasks(group.Tasks, TaskSort{Key: SortByPriority, Descending: true})
+		}
+		report.Days = append(report.Days, day)
+	}
+	sort.Slice(report.Days, func(i, j int) bool { return report.Days[i].Date < report.Days[j].Date })
+
+	for projectID, count := range totals {
+		report.Projects = append(report.Projects, ProjectCount{
+			ProjectID:   projectID,
+			ProjectName: names.name(projectID),
+			Count:       count,
+		})
+	}
+	sortByProjectName(report.Projects, func(p ProjectCount) (string, int) { return p.ProjectName, p.ProjectID })
+
+	return report
+}
+
+// groupByProject splits tasks into per-project groups ordered by project name
+func groupByProject(tasks []Task, names projectNameIndex) []ProjectTasks {
+	grouped := make(map[int][]Task)
+	for _, task := range tasks {
+		grouped[task.ProjectID] = append(grouped[task.ProjectID], task)
+	}
+
+	var groups []ProjectTasks
+	for projectID, projectTasks := range grouped {
+		groups = append(groups, ProjectTasks{
+			ProjectID:   projectID,
+			ProjectName: names.name(projectID),
+			Count:       len(projectTasks),
+			Tasks:       projectTasks,
+		})
+	}
+	sortByProjectName(groups, func(p ProjectTasks) (string, int) { return p.ProjectName, p.ProjectID })
+	return groups
+}
+
+type projectNameIndex map[int]string
+
+func projectNames(projects []Project) projectNameIndex {
+	names := make(projectNameIndex, len(projects))
+	for _, project := range projects {
+		names[project.ID] = project.Name
+	}
+	return names
+}
+
+// name returns the project's name, a placeholder for unknown projects, or NoProjectName for 0
+func (n projectNameIndex) name(id int) string {
+	if id == 0 {
+		return NoProjectName
+	}
+	if name, ok := n[id]; ok {
+		return name
+	}
+	return "Project " + strconv.Itoa(id)
+}
+
+// sortByProjectName orders project groups by name, putting tasks without a project last
+func sortByProjectName[T any](items []T, key func(T) (string, int)) {
+	sort.SliceStable(items, func(i, j int) bool {
+		nameI, idI := key(items[i])
+		nameJ, idJ := key(items[j])
+		if (idI == 0) != (idJ == 0) {
+			return idJ == 0
+		}
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return idI < idJ
+	})
+}
@@ -0,0 +1,76 @@
+package tudidi
+
+import (
+	"testing"
+)
+
+func TestBuildDueReport(t *testing.T) {
+	projects := []Project{{ID: 10, Name: "Work"}, {ID: 20, Name: "Home"}}
+	tasks := []Task{
+		{ID: 1, Name: "Work low", ProjectID: 10, DueDate: "2026-10-14"},
+		{ID: 2, Name: "Work high", ProjectID: 10, DueDate: "2026-10-14T00:00:00.000Z", Priority: 2},
+		{ID: 3, Name: "Home", ProjectID: 20, DueDate: "2026-10-14"},
+		{ID: 4, Name: "Loose", DueDate: "2026-10-12"},
+		{ID: 5, Name: "Unknown project", ProjectID: 99, DueDate: "2026-10-12"},
+		{ID: 6, Name: "Completed", ProjectID: 10, DueDate: "2026-10-13", Status: 2},
+		{ID: 7, Name: "Too late", ProjectID: 10, DueDate: "2026-10-16"},
+		{ID: 8, Name: "Too early", ProjectID: 10, DueDate: "2026-10-01"},
+		{ID: 9, Name: "No date", ProjectID: 10},
+	}
+
+	report := BuildDueReport(tasks, projects, "2026-10-10", "2026-10-15")
+
+	if report.Count != 5 {
+		t.Errorf("Expected 5 tasks, got %d", report.Count)
+	}
+	if len(report.Days) != 2 {
+		t.Fatalf("Expected 2 days, got %d", len(report.Days))
+	}
+
+	first := report.Days[0]
+	if first.Date != "2026-10-12" || first.Count != 2 {
+		t.Errorf("Expected 2 tasks on 2026-10-12, got %d on %s", first.Count, first.Date)
+	}
+	if len(first.Projects) != 2 || first.Projects[0].ProjectName != "Project 99" || first.Projects[1].ProjectName != NoProjectName {
+		t.Errorf("Expected unknown project before no project, got %+v", first.Projects)
+	}
+
+	second := report.Days[1]
+	if second.Date != "2026-10-14" || second.Count != 3 {
+		t.Errorf("Expected 3 tasks on 2026-10-14, got %d on %s", second.Count, second.Date)
+	}
+	if len(second.Projects) != 2 || second.Projects[0].ProjectName != "Home" || second.Projects[1].ProjectName != "Work" {
+		t.Fatalf("Expected Home then Work, got %+v", second.Projects)
+	}
+	if ids := taskIDs(second.Projects[1].Tasks); !equalIDs(ids, []int{2, 1}) {
+		t.Errorf("Expected work tasks by priority [2 1], got %v", ids)
+	}
+
+	expected := []ProjectCount{
+		{ProjectID: 20, ProjectName: "Home", Count: 1},
+		{ProjectID: 99, ProjectName: "Project 99", Count: 1},
+		{ProjectID: 10, ProjectName: "Work", Count: 2},
+		{ProjectID: 0, ProjectName: NoProjectName, Count: 1},
+	}
+	if len(report.Projects) != len(expected) {
+		t.Fatalf("Expected project totals %+v, got %+v", expected, report.Projects)
+	}
+	for i := range expected {
+		if report.Projects[i] != expected[i] {
+			t.Errorf("Expected project total %+v, got %+v", expected[i], report.Projects[i])
+		}
+	}
+}
+
+func TestBuildDueReportWithoutLowerBound(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Name: "Ancient", DueDate: "2020-01-01"},
+		{ID: 2, Name: "Yesterday", DueDate: "2026-10-15"},
+		{ID: 3, Name: "Today", DueDate: "2026-10-16"},
+	}
+
+	report := BuildDueReport(tasks, nil, "", "2026-10-15")
+	if report.Count != 2 || len(report.Days) != 2 || report.Days[0].Date != "2020-01-01" {
+		t.Errorf("Expected the two past tasks oldest first, got %+v", report)
+	}
+}
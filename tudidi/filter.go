@@ -0,0 +1,128 @@
+package tudidi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// TaskFilter narrows down and orders GetTasks. The zero value matches every open task;
+// set IncludeCompleted to also get completed and archived ones.
+//
+// Without a Sort, tasks for a single project follow that project's TaskSortOrder
+// and everything else is ordered by task ID, so results are stable between calls.
+type TaskFilter struct {
+	Status           *Status
+	ProjectID        int
+	Tag              string
+	Priority         Priority
+	DueBefore        string // inclusive, YYYY-MM-DD
+	DueAfter         string // inclusive, YYYY-MM-DD
+	TodayOnly        bool
+	IncludeCompleted bool
+	Sort             TaskSort
+}
+
+func (f TaskFilter) validate() error {
+	if f.Status != nil {
+		if _, err := f.Status.Code(); err != nil {
+			return err
+		}
+	}
+	if f.Priority != "" {
+		if _, err := f.Priority.Code(); err != nil {
+			return err
+		}
+	}
+	if f.DueBefore != "" {
+		if err := validateDate(f.DueBefore); err != nil {
+			return fmt.Errorf("invalid due before date: %w", err)
+		}
+	}
+	if f.DueAfter != "" {
+		if err := validateDate(f.DueAfter); err != nil {
+			return fmt.Errorf("invalid due after date: %w", err)
+		}
+	}
+	if f.DueBefore != "" && f.DueAfter != "" && DateOnly(f.DueAfter) > DateOnly(f.DueBefore) {
+		return fmt.Errorf("due after date %s is later than due before date %s", f.DueAfter, f.DueBefore)
+	}
+	return nil
+}
+
+// query returns the subset of the filter Tudidi can apply server-side
+func (f TaskFilter) query() url.Values {
+	query := url.Values{}
+	if f.ProjectID != 0 {
+		query.Set("project_id", strconv.Itoa(f.ProjectID))
+	}
+	if f.Tag != "" {
+		query.Set("tag", f.Tag)
+	}
+	if f.TodayOnly {
+		query.Set("type", "today")
+	}
+	return query
+}
+
+// matches applies the whole filter client-side, so results are correct even
+// when the server ignores a query parameter
+func (f TaskFilter) matches(task Task) bool {
+	if f.Status != nil {
+		code, _ := f.Status.Code()
+		if task.Status != code {
+			return false
+		}
+	} else if !f.IncludeCompleted && task.IsClosed() {
+		return false
+	}
+
+	if f.ProjectID != 0 && task.ProjectID != f.ProjectID {
+		return false
+	}
+	if f.Tag != "" && !task.HasTag(f.Tag) {
+		return false
+	}
+	if f.Priority != "" {
+		code, _ := f.Priority.Code()
+		if task.Priority != code {
+			return false
+		}
+	}
+	if f.TodayOnly && !task.Today {
+		return false
+	}
+
+	if f.DueBefore != "" || f.DueAfter != "" {
+		if task.DueDate == "" {
+			return false
+		}
+		due := DateOnly(task.DueDate)
+		if f.DueBefore != "" && due > DateOnly(f.DueBefore) {
+			return false
+		}
+		if f.DueAfter != "" && due < DateOnly(f.DueAfter) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsClosed reports whether the task is completed or archived
+func (t Task) IsClosed() bool {
+	completed, _ := Completed.Code()
+	archived, _ := Archived.Code()
+	return t.Status == completed || t.Status == archived
+}
+
+// filterTasks returns the tasks matching the filter, keeping their order
+func filterTasks(tasks []Task, filter TaskFilter) []Task {
+	filtered := make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if filter.matches(task) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,88 @@
+package tudidi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTaskFilterMatches(t *testing.T) {
+	completed := Completed
+	tasks := []Task{
+		{ID: 1, Name: "Call plumber", Status: 0, Priority: 2, ProjectID: 10, DueDate: "2026-10-16", Today: true, Tags: []Tag{{Name: "@calls"}}},
+		{ID: 2, Name: "Write report", Status: 1, Priority: 1, ProjectID: 20, DueDate: "2026-10-20T00:00:00.000Z"},
+		{ID: 3, Name: "Pay invoice", Status: 2, Priority: 0, ProjectID: 10, DueDate: "2026-10-10"},
+		{ID: 4, Name: "Someday idea", Status: 0, Priority: 0},
+	}
+
+	tests := []struct {
+		name     string
+		filter   TaskFilter
+		expected []int
+	}{
+		{"Zero filter hides completed", TaskFilter{}, []int{1, 2, 4}},
+		{"Include completed", TaskFilter{IncludeCompleted: true}, []int{1, 2, 3, 4}},
+		{"Completed status", TaskFilter{Status: &completed}, []int{3}},
+		{"Project", TaskFilter{ProjectID: 10, IncludeCompleted: true}, []int{1, 3}},
+		{"Tag is case-insensitive", TaskFilter{Tag: "@CALLS"}, []int{1}},
+		{"Priority", TaskFilter{Priority: PriorityMedium}, []int{2}},
+		{"Today only", TaskFilter{TodayOnly: true}, []int{1}},
+		{"Due before is inclusive", TaskFilter{DueBefore: "2026-10-16"}, []int{1}},
+		{"Due after handles timestamps", TaskFilter{DueAfter: "2026-10-17"}, []int{2}},
+		{"Due range", TaskFilter{DueAfter: "2026-10-01", DueBefore: "2026-10-31", IncludeCompleted: true}, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := filterTasks(tasks, tt.filter)
+
+			var ids []int
+			for _, task := range filtered {
+				ids = append(ids, task.ID)
+			}
+			if len(ids) != len(tt.expected) {
+				t.Fatalf("Expected tasks %v, got %v", tt.expected, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.expected[i] {
+					t.Fatalf("Expected tasks %v, got %v", tt.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskFilterValidation(t *testing.T) {
+	bad := Status("done")
+
+	tests := []struct {
+		name          string
+		filter        TaskFilter
+		errorContains string
+	}{
+		{"Bad status", TaskFilter{Status: &bad}, "invalid status"},
+		{"Bad priority", TaskFilter{Priority: "urgent"}, "invalid priority"},
+		{"Bad due before", TaskFilter{DueBefore: "friday"}, "invalid due before date"},
+		{"Bad due after", TaskFilter{DueAfter: "monday"}, "invalid due after date"},
+		{"Inverted range", TaskFilter{DueAfter: "2026-10-20", DueBefore: "2026-10-10"}, "is later than"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.validate()
+			if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+			}
+		})
+	}
+}
+
+func TestTaskFilterQuery(t *testing.T) {
+	query := TaskFilter{ProjectID: 10, Tag: "@home", TodayOnly: true, Priority: PriorityHigh}.query()
+
+	if query.Encode() != "project_id=10&tag=%40home&type=today" {
+		t.Errorf("Unexpected query: %s", query.Encode())
+	}
+	if len(TaskFilter{}.query()) != 0 {
+		t.Error("Expected empty query for zero filter")
+	}
+}
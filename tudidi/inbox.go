@@ -0,0 +1,124 @@
+package tudidi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type InboxItem struct {
+	ID        int    `json:"id"`
+	UUID      string `json:"uuid,omitempty"`
+	Content   string `json:"content"`
+	Status    string `json:"status,omitempty"`
+	Source    string `json:"source,omitempty"`
+	UserID    int    `json:"user_id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type CreateInboxItemRequest struct {
+	Content string `json:"content"`
+	Source  string `json:"source,omitempty"`
+}
+
+func (api *API) GetInboxItems() ([]InboxItem, error) {
+	var items []InboxItem
+	if err := api.doGet("/api/inbox", &items); err != nil {
+		return nil, fmt.Errorf("failed to get inbox items: %w", err)
+	}
+	return items, nil
+}
+
+func (api *API) GetInboxItem(id int) (*InboxItem, error) {
+	var item InboxItem
+	endpoint := "/api/inbox/" + strconv.Itoa(id)
+	if err := api.doGet(endpoint, &item); err != nil {
+		return nil, fmt.Errorf("failed to get inbox item: %w", err)
+	}
+	return &item, nil
+}
+
+func (api *API) CreateInboxItem(content string) (*InboxItem, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("inbox item content cannot be empty")
+	}
+
+	var item InboxItem
+	req := CreateInboxItemRequest{Content: content, Source: "mcp"}
+	if err := api.doPost("/api/inbox", req, &item); err != nil {
+		return nil, fmt.Errorf("failed to create inbox item: %w", err)
+	}
+	return &item, nil
+}
+
+func (api *API) DeleteInboxItem(id int) error {
+	endpoint := "/api/inbox/" + strconv.Itoa(id)
+	if err := api.doDelete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete inbox item: %w", err)
+	}
+	return nil
+}
+
+// MarkInboxItemProcessed removes the item from the inbox without deleting it
+func (api *API) MarkInboxItemProcessed(id int) error {
+	endpoint := "/api/inbox/" + strconv.Itoa(id) + "/process"
+	if err := api.doPatch(endpoint, struct{}{}, nil); err != nil {
+		return fmt.Errorf("failed to process inbox item: %w", err)
+	}
+	return nil
+}
+
+// ProcessInboxItemAsTask creates a task from an inbox item and marks the item processed.
+// If req.Name is empty the item content is used as the task name.
+func (api *API) ProcessInboxItemAsTask(id int, req CreateTaskRequest) (*Task, error) {
+	item, err := api.GetInboxItem(id)
+	if err != nil {
+		return nil, fmt.Errorf("inbox item with id %d not found: %w", id, err)
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		req.Name = item.Content
+	}
+	if req.Status == "" {
+		req.Status = NotStarted
+	}
+
+	task, err := api.CreateTask(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := api.MarkInboxItemProcessed(id); err != nil {
+		return task, fmt.Errorf("task %d created but inbox item was not cleared: %w", task.ID, err)
+	}
+	return task, nil
+}
+
+// ProcessInboxItemAsNote creates a note from an inbox item and marks the item processed.
+// If req.Title is empty the item content is used as the title; if req.Content is empty
+// the item content is also used as the body.
+func (api *API) ProcessInboxItemAsNote(id int, req CreateNoteRequest) (*Note, error) {
+	item, err := api.GetInboxItem(id)
+	if err != nil {
+		return nil, fmt.Errorf("inbox item with id %d not found: %w", id, err)
+	}
+
+	if strings.TrimSpace(req.Title) == "" {
+		req.Title = item.Content
+	}
+	if strings.TrimSpace(req.Content) == "" {
+		req.Content = item.Content
+	}
+
+	note, err := api.CreateNote(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := api.MarkInboxItemProcessed(id); err != nil {
+		return note, fmt.Errorf("note %d created but inbox item was not cleared: %w", note.ID, err)
+	}
+	return note, nil
+}
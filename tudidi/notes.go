@@ -0,0 +1,126 @@
+package tudidi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Note struct {
+	ID        int    `json:"id"`
+	UUID      string `json:"uuid,omitempty"`
+	Title     string `json:"title"`
+	Content   string `json:"content,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+	UserID    int    `json:"user_id,omitempty"`
+	Tags      []Tag  `json:"tags,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type CreateNoteRequest struct {
+	Title     string `json:"title"`
+	Content   string `json:"content,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+type UpdateNoteRequest struct {
+	Title     string `json:"title,omitempty"`
+	Content   string `json:"content,omitempty"`
+	ProjectID *int   `json:"project_id,omitempty"`
+}
+
+func (api *API) GetNotes() ([]Note, error) {
+	var notes []Note
+	if err := api.doGet("/api/notes", &notes); err != nil {
+		return nil, fmt.Errorf("failed to get notes: %w", err)
+	}
+	return notes, nil
+}
+
+func (api *API) GetNote(id int) (*Note, error) {
+	var note Note
+	endpoint := "/api/note/" + strconv.Itoa(id)
+	if err := api.doGet(endpoint, &note); err != nil {
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	return &note, nil
+}
+
+func (api *API) CreateNote(req CreateNoteRequest) (*Note, error) {
+	req.Title = strings.TrimSpace(req.Title)
+	if req.Title == "" {
+		return nil, fmt.Errorf("note title cannot be empty")
+	}
+
+	var note Note
+	if err := api.doPost("/api/note", req, &note); err != nil {
+		return nil, fmt.Errorf("failed to create note: %w", err)
+	}
+	return &note, nil
+}
+
+func (api *API) UpdateNote(id int, req UpdateNoteRequest) (*Note, error) {
+	if req.Title == "" && req.Content == "" && req.ProjectID == nil {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	var note Note
+	endpoint := "/api/note/" + strconv.Itoa(id)
+	if err := api.doPatch(endpoint, req, &note); err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+	return &note, nil
+}
+
+// AppendToNote adds text to the end of a note's content, separated by a blank line
+func (api *API) AppendToNote(id int, text string) (*Note, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("text to append cannot be empty")
+	}
+
+	note, err := api.GetNote(id)
+	if err != nil {
+		return nil, fmt.Errorf("note with id %d not found: %w", id, err)
+	}
+
+	content := text
+	if existing := strings.TrimRight(note.Content, "\n"); existing != "" {
+		content = existing + "\n\n" + text
+	}
+
+	return api.UpdateNote(id, UpdateNoteRequest{Content: content})
+}
+
+func (api *API) DeleteNote(id int) error {
+	endpoint := "/api/note/" + strconv.Itoa(id)
+	if err := api.doDelete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+	return nil
+}
+
+// SearchNotes returns notes whose title or content contains the query (case-insensitive)
+func (api *API) SearchNotes(query string) ([]Note, error) {
+	if query == "" {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	notes, err := api.GetNotes()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []Note
+	searchLower := strings.ToLower(query)
+
+	for _, note := range notes {
+		if strings.Contains(strings.ToLower(note.Title), searchLower) ||
+			strings.Contains(strings.ToLower(note.Content), searchLower) {
+			filtered = append(filtered, note)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,80 @@
+package tudidi
+
+import (
+	"fmt"
+	"time"
+)
+
+type RecurrenceType string
+
+const (
+	RecurrenceNone           RecurrenceType = "none"
+	RecurrenceDaily          RecurrenceType = "daily"
+	RecurrenceWeekly         RecurrenceType = "weekly"
+	RecurrenceMonthly        RecurrenceType = "monthly"
+	RecurrenceMonthlyWeekday RecurrenceType = "monthly_weekday"
+	RecurrenceMonthlyLastDay RecurrenceType = "monthly_last_day"
+)
+
+// Recurrence holds Tudidi's recurrence fields. It is embedded in Task and
+// CreateTaskRequest so the fields serialize flat, the way Tudidi expects them.
+type Recurrence struct {
+	RecurrenceType        RecurrenceType `json:"recurrence_type,omitempty"`
+	RecurrenceInterval    int            `json:"recurrence_interval,omitempty"`
+	RecurrenceEndDate     string         `json:"recurrence_end_date,omitempty"`
+	RecurrenceWeekday     *int           `json:"recurrence_weekday,omitempty"`
+	RecurrenceMonthDay    *int           `json:"recurrence_month_day,omitempty"`
+	RecurrenceWeekOfMonth *int           `json:"recurrence_week_of_month,omitempty"`
+}
+
+// IsRecurring reports whether the recurrence describes a repeating task
+func (r Recurrence) IsRecurring() bool {
+	return r.RecurrenceType != "" && r.RecurrenceType != RecurrenceNone
+}
+
+func (r Recurrence) Validate() error {
+	switch r.RecurrenceType {
+	case "", RecurrenceNone, RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceMonthlyLastDay:
+	case RecurrenceMonthlyWeekday:
+		if r.RecurrenceWeekday == nil || r.RecurrenceWeekOfMonth == nil {
+			return fmt.Errorf("monthly_weekday recurrence requires a weekday and a week of month")
+		}
+	default:
+		return fmt.Errorf("invalid recurrence type '%s': must be one of none, daily, weekly, monthly, monthly_weekday, monthly_last_day", r.RecurrenceType)
+	}
+
+	if r.RecurrenceInterval < 0 {
+		return fmt.Errorf("recurrence interval must be positive, got: %d", r.RecurrenceInterval)
+	}
+	if r.RecurrenceWeekday != nil && (*r.RecurrenceWeekday < 0 || *r.RecurrenceWeekday > 6) {
+		return fmt.Errorf("recurrence weekday must be between 0 (Sunday) and 6 (Saturday), got: %d", *r.RecurrenceWeekday)
+	}
+	if r.RecurrenceMonthDay != nil && (*r.RecurrenceMonthDay < 1 || *r.RecurrenceMonthDay > 31) {
+		return fmt.Errorf("recurrence month day must be between 1 and 31, got: %d", *r.RecurrenceMonthDay)
+	}
+	if r.RecurrenceWeekOfMonth != nil && (*r.RecurrenceWeekOfMonth < 1 || *r.RecurrenceWeekOfMonth > 5) {
+		return fmt.Errorf("recurrence week of month must be between 1 and 5, got: %d", *r.RecurrenceWeekOfMonth)
+	}
+	if r.RecurrenceEndDate != "" {
+		if err := validateDate(r.RecurrenceEndDate); err != nil {
+			return fmt.Errorf("invalid recurrence end date: %w", err)
+		}
+	}
+	return nil
+}
+
+// DateOnly trims a timestamp such as 2025-01-31T00:00:00.000Z down to its date part
+func DateOnly(value string) string {
+	if len(value) > 10 {
+		return value[:10]
+	}
+	return value
+}
+
+// validateDate checks that value starts with a YYYY-MM-DD date
+func validateDate(value string) error {
+	if _, err := time.Parse("2006-01-02", DateOnly(value)); err != nil {
+		return fmt.Errorf("'%s' is not a YYYY-MM-DD date", value)
+	}
+	return nil
+}
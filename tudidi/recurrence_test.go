@@ -0,0 +1,68 @@
+package tudidi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRecurrenceValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		recurrence    Recurrence
+		errorContains string
+	}{
+		{"Empty", Recurrence{}, ""},
+		{"None", Recurrence{RecurrenceType: RecurrenceNone}, ""},
+		{"Weekly on Monday", Recurrence{RecurrenceType: RecurrenceWeekly, RecurrenceInterval: 2, RecurrenceWeekday: intPtr(1)}, ""},
+		{"Monthly with end date", Recurrence{RecurrenceType: RecurrenceMonthly, RecurrenceMonthDay: intPtr(15), RecurrenceEndDate: "2026-12-31"}, ""},
+		{"Unknown type", Recurrence{RecurrenceType: "yearly"}, "invalid recurrence type"},
+		{"Negative interval", Recurrence{RecurrenceType: RecurrenceDaily, RecurrenceInterval: -1}, "interval must be positive"},
+		{"Weekday out of range", Recurrence{RecurrenceType: RecurrenceWeekly, RecurrenceWeekday: intPtr(7)}, "weekday must be between"},
+		{"Month day out of range", Recurrence{RecurrenceType: RecurrenceMonthly, RecurrenceMonthDay: intPtr(32)}, "month day must be between"},
+		{"Monthly weekday incomplete", Recurrence{RecurrenceType: RecurrenceMonthlyWeekday, RecurrenceWeekday: intPtr(1)}, "requires a weekday and a week of month"},
+		{"Bad end date", Recurrence{RecurrenceType: RecurrenceDaily, RecurrenceEndDate: "next year"}, "invalid recurrence end date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.recurrence.Validate()
+			if tt.errorContains == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+			}
+		})
+	}
+}
+
+func TestTaskRecurrenceJSON(t *testing.T) {
+	body := `{"id": 1, "name": "Water plants", "recurrence_type": "weekly", "recurrence_interval": 2, "recurrence_weekday": 0, "recurrence_end_date": null}`
+
+	var task Task
+	if err := json.Unmarshal([]byte(body), &task); err != nil {
+		t.Fatalf("Failed to parse task: %v", err)
+	}
+
+	if !task.IsRecurring() {
+		t.Error("Expected task to be recurring")
+	}
+	if task.RecurrenceInterval != 2 {
+		t.Errorf("Expected interval 2, got %d", task.RecurrenceInterval)
+	}
+	if task.RecurrenceWeekday == nil || *task.RecurrenceWeekday != 0 {
+		t.Errorf("Expected weekday 0 (Sunday) to be kept, got %v", task.RecurrenceWeekday)
+	}
+
+	encoded, err := json.Marshal(CreateTaskRequest{Name: "Standup", Recurrence: Recurrence{RecurrenceType: RecurrenceDaily}})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+	if !strings.Contains(string(encoded), `"recurrence_type":"daily"`) {
+		t.Errorf("Expected recurrence fields to be sent flat, got %s", encoded)
+	}
+}
@@ -0,0 +1,150 @@
+package tudidi
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AmbiguousProjectError is returned by ResolveProject when a name matches more than one project
+type AmbiguousProjectError struct {
+	Name       string
+	Candidates []Project
+}
+
+func (e *AmbiguousProjectError) Error() string {
+	names := make([]string, len(e.Candidates))
+	for i, project := range e.Candidates {
+		names[i] = fmt.Sprintf("%s (ID %d)", project.Name, project.ID)
+	}
+	return fmt.Sprintf("project '%s' is ambiguous, it matches: %s; use a more specific name or the project ID",
+		e.Name, strings.Join(names, ", "))
+}
+
+// ResolveProject finds the single project a user-supplied name refers to.
+// An exact (case-insensitive) name wins, then a unique substring match from
+// SearchProjectsByName, then a unique fuzzy match tolerating typos and word
+// prefixes ("home reno" for "Home Renovation"). Several equally good matches
+// yield an *AmbiguousProjectError.
+func (api *API) ResolveProject(name string) (*Project, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("project name cannot be empty")
+	}
+
+	candidates, err := api.SearchProjectsByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		projects, err := api.GetProjects()
+		if err != nil {
+			return nil, err
+		}
+		candidates = fuzzyMatchProjects(projects, name)
+	}
+
+	return pickProject(candidates, name)
+}
+
+// pickProject narrows candidates to a single project, preferring exact name matches
+func pickProject(candidates []Project, name string) (*Project, error) {
+	var exact []Project
+	for _, project := range candidates {
+		if strings.EqualFold(strings.TrimSpace(project.Name), name) {
+			exact = append(exact, project)
+		}
+	}
+	if len(exact) > 0 {
+		candidates = exact
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no project matches '%s'", name)
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, &AmbiguousProjectError{Name: name, Candidates: candidates}
+	}
+}
+
+// fuzzyMatchProjects returns the projects whose names are closest to name,
+// either because every word of name starts a word of the project name
+// ("home reno" for "Home Renovation") or because the names are within a
+// small edit distance of each other. Only the best-scoring projects are kept.
+func fuzzyMatchProjects(projects []Project, name string) []Project {
+	query := strings.ToLower(name)
+	queryWords := splitWords(query)
+	maxDistance := max(1, len([]rune(query))/4)
+
+	best := maxDistance + 1
+	var matches []Project
+	for _, project := range projects {
+		projectName := strings.ToLower(strings.TrimSpace(project.Name))
+
+		distance := levenshtein(query, projectName)
+		if wordsPrefixMatch(queryWords, splitWords(projectName)) {
+			distance = 0
+		}
+		if distance > maxDistance || distance > best {
+			continue
+		}
+		if distance < best {
+			best = distance
+			matches = nil
+		}
+		matches = append(matches, project)
+	}
+
+	return matches
+}
+
+// wordsPrefixMatch reports whether each query word starts a distinct word of the name, in order
+func wordsPrefixMatch(queryWords, nameWords []string) bool {
+	if len(queryWords) == 0 {
+		return false
+	}
+	next := 0
+	for _, word := range queryWords {
+		for next < len(nameWords) && !strings.HasPrefix(nameWords[next], word) {
+			next++
+		}
+		if next == len(nameWords) {
+			return false
+		}
+		next++
+	}
+	return true
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
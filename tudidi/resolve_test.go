@@ -0,0 +1,140 @@
+package tudidi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPickProject(t *testing.T) {
+	home := Project{ID: 1, Name: "Home"}
+	renovation := Project{ID: 2, Name: "Home Renovation"}
+	homework := Project{ID: 3, Name: "Homework"}
+
+	tests := []struct {
+		name       string
+		candidates []Project
+		query      string
+		expectedID int
+		ambiguous  []int
+	}{
+		{"Single candidate", []Project{renovation}, "reno", 2, nil},
+		{"Exact name wins", []Project{home, renovation, homework}, "home", 1, nil},
+		{"Several partial matches", []Project{renovation, homework}, "home", 0, []int{2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, err := pickProject(tt.candidates, tt.query)
+			if tt.ambiguous != nil {
+				var ambiguous *AmbiguousProjectError
+				if !errors.As(err, &ambiguous) {
+					t.Fatalf("Expected AmbiguousProjectError, got %v", err)
+				}
+				if len(ambiguous.Candidates) != len(tt.ambiguous) {
+					t.Fatalf("Expected %d candidates, got %d", len(tt.ambiguous), len(ambiguous.Candidates))
+				}
+				for i, id := range tt.ambiguous {
+					if ambiguous.Candidates[i].ID != id {
+						t.Errorf("Expected candidate %d to be project %d, got %d", i, id, ambiguous.Candidates[i].ID)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if project.ID != tt.expectedID {
+				t.Errorf("Expected project %d, got %d", tt.expectedID, project.ID)
+			}
+		})
+	}
+}
+
+func TestPickProjectNoMatch(t *testing.T) {
+	_, err := pickProject(nil, "garden")
+	if err == nil || !strings.Contains(err.Error(), "no project matches 'garden'") {
+		t.Fatalf("Expected no match error, got %v", err)
+	}
+}
+
+func TestAmbiguousProjectErrorListsCandidates(t *testing.T) {
+	err := &AmbiguousProjectError{
+		Name:       "home",
+		Candidates: []Project{{ID: 2, Name: "Home Renovation"}, {ID: 3, Name: "Homework"}},
+	}
+	msg := err.Error()
+	for _, want := range []string{"'home'", "Home Renovation (ID 2)", "Homework (ID 3)"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in error message, got %q", want, msg)
+		}
+	}
+}
+
+func TestFuzzyMatchProjects(t *testing.T) {
+	projects := []Project{
+		{ID: 1, Name: "Home Renovation"},
+		{ID: 2, Name: "Marketing Website"},
+		{ID: 3, Name: "Garden"},
+		{ID: 4, Name: "Gardens"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []int
+	}{
+		{"Word prefixes", "home reno", []int{1}},
+		{"Word prefixes out of order", "reno home", nil},
+		{"Abbreviated words", "mkt web", nil},
+		{"Typo", "Marketng Website", []int{2}},
+		{"Closest name wins", "Gardn", []int{3}},
+		{"Equally close names are both kept", "Gardenz", []int{3, 4}},
+		{"Too different", "Taxes", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := fuzzyMatchProjects(projects, tt.query)
+
+			var ids []int
+			for _, project := range matches {
+				ids = append(ids, project.ID)
+			}
+			if len(ids) != len(tt.expected) {
+				t.Fatalf("Expected projects %v, got %v", tt.expected, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.expected[i] {
+					t.Fatalf("Expected projects %v, got %v", tt.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"garden", "garden", 0},
+		{"café", "cafe", 1},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.expected {
+			t.Errorf("levenshtein(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestResolveProjectValidation(t *testing.T) {
+	api := &API{}
+	if _, err := api.ResolveProject("  "); err == nil || err.Error() != "project name cannot be empty" {
+		t.Fatalf("Expected empty name error, got %v", err)
+	}
+}
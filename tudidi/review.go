@@ -0,0 +1,164 @@
+package tudidi
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultStaleDays is how long an open task can go without updates before the weekly review flags it
+const DefaultStaleDays = 14
+
+// WeeklyReview summarises a week of activity for a GTD-style weekly review
+type WeeklyReview struct {
+	WeekStart string `json:"week_start"`
+	WeekEnd   string `json:"week_end"`
+
+	// Completed holds tasks completed this week, grouped by project
+	Completed      []ProjectTasks `json:"completed"`
+	CompletedCount int            `json:"completed_count"`
+
+	// Created holds tasks added this week, whatever their status now
+	Created      []Task `json:"created"`
+	CreatedCount int    `json:"created_count"`
+
+	// Stale holds open tasks not updated for StaleDays or more, least recently updated first
+	StaleDays  int    `json:"stale_days"`
+	Stale      []Task `json:"stale"`
+	StaleCount int    `json:"stale_count"`
+
+	// ProjectsWithoutNextAction holds active projects that have no open tasks
+	ProjectsWithoutNextAction []Project `json:"projects_without_next_action"`
+
+	// Slipping holds open tasks due by the end of the week, earliest due first
+	Slipping      []Task `json:"slipping"`
+	SlippingCount int    `json:"slipping_count"`
+}
+
+// BuildWeeklyReview reviews the week (Monday to Sunday) containing now. Timestamps
+// are compared as dates in now's location; staleDays <= 0 uses DefaultStaleDays.
+func BuildWeeklyReview(tasks []Task, projects []Project, now time.Time, staleDays int) WeeklyReview {
+	if staleDays <= 0 {
+		staleDays = DefaultStaleDays
+	}
+
+	location := now.Location()
+	today := startOfDay(now)
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	review := WeeklyReview{
+		WeekStart: weekStart.Format("2006-01-02"),
+		WeekEnd:   weekEnd.Format("2006-01-02"),
+		StaleDays: staleDays,
+	}
+	staleBefore := today.AddDate(0, 0, -staleDays).Format("2006-01-02")
+	inWeek := func(date string) bool {
+		return date != "" && date >= review.WeekStart && date <= review.WeekEnd
+	}
+
+	var completed []Task
+	openByProject := make(map[int]int)
+	for _, task := range tasks {
+		if task.IsClosed() {
+			if inWeek(localDate(task.CompletedAt, location)) {
+				completed = append(completed, task)
+			}
+		} else {
+			openByProject[task.ProjectID]++
+
+			lastTouched := localDate(task.UpdatedAt, location)
+			if lastTouched == "" {
+				lastTouched = localDate(task.CreatedAt, location)
+			}
+			if lastTouched != "" && lastTouched <= staleBefore {
+				review.Stale = append(review.Stale, task)
+			}
+
+			if due := DateOnly(task.DueDate); due != "" && due <= review.WeekEnd {
+				review.Slipping = append(review.Slipping, task)
+			}
+		}
+
+		if inWeek(localDate(task.CreatedAt, location)) {
+			review.Created = append(review.Created, task)
+		}
+	}
+
+	review.Completed = groupByProject(completed, projectNames(projects))
+	review.CompletedCount = len(completed)
+	review.CreatedCount = len(review.Created)
+	review.StaleCount = len(review.Stale)
+	review.SlippingCount = len(review.Slipping)
+
+	sort.SliceStable(review.Stale, func(i, j int) bool {
+		return lastUpdated(review.Stale[i]).Before(lastUpdated(review.Stale[j]))
+	})
+	SortTasks(review.Slipping, TaskSort{Key: SortByDueDate})
+	SortTasks(review.Created, TaskSort{Key: SortByCreated})
+
+	for _, project := range projects {
+		if project.Active && openByProject[project.ID] == 0 {
+			review.ProjectsWithoutNextAction = append(review.ProjectsWithoutNextAction, project)
+		}
+	}
+
+	return review
+}
+
+// CompletedOn returns the closed tasks completed on day's date, in day's location
+func CompletedOn(tasks []Task, day time.Time) []Task {
+	date := day.Format("2006-01-02")
+	var completed []Task
+	for _, task := range tasks {
+		if task.IsClosed() && localDate(task.CompletedAt, day.Location()) == date {
+			completed = append(completed, task)
+		}
+	}
+	return completed
+}
+
+// lastUpdated returns when the task was last touched, falling back to its creation time
+func lastUpdated(task Task) time.Time {
+	if updated, ok := parseTimestamp(task.UpdatedAt); ok {
+		return updated
+	}
+	created, _ := parseTimestamp(task.CreatedAt)
+	return created
+}
+
+// timestampLayouts are the formats Tudidi uses for created/updated/completed timestamps
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05",
+}
+
+// parseTimestamp parses a Tudidi timestamp; timestamps without a zone are taken as UTC
+func parseTimestamp(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// localDate returns the YYYY-MM-DD date of a timestamp in location, or "" if it can't be parsed.
+// Plain dates have no time of day to convert and are returned unchanged.
+func localDate(value string, location *time.Location) string {
+	if len(value) == len("2006-01-02") {
+		if _, err := time.Parse("2006-01-02", value); err == nil {
+			return value
+		}
+	}
+	parsed, ok := parseTimestamp(value)
+	if !ok {
+		return ""
+	}
+	return parsed.In(location).Format("2006-01-02")
+}
@@ -0,0 +1,119 @@
+package tudidi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildWeeklyReview(t *testing.T) {
+	// Friday 16 October 2026; the week runs Monday 12th to Sunday 18th
+	now := time.Date(2026, time.October, 16, 15, 0, 0, 0, time.UTC)
+
+	projects := []Project{
+		{ID: 10, Name: "Work", Active: true},
+		{ID: 20, Name: "Home", Active: true},
+		{ID: 30, Name: "Garden", Active: true},
+		{ID: 40, Name: "Archived", Active: false},
+	}
+	tasks := []Task{
+		{ID: 1, Name: "Done this week", ProjectID: 10, Status: 2, CompletedAt: "2026-10-13T09:00:00.000Z", CreatedAt: "2026-09-01T09:00:00.000Z"},
+		{ID: 2, Name: "Done last week", ProjectID: 10, Status: 2, CompletedAt: "2026-10-09T09:00:00.000Z", CreatedAt: "2026-09-01T09:00:00.000Z"},
+		{ID: 3, Name: "New and open", ProjectID: 10, CreatedAt: "2026-10-14T08:00:00.000Z", UpdatedAt: "2026-10-14T08:00:00.000Z"},
+		{ID: 4, Name: "New and done", ProjectID: 20, Status: 2, CreatedAt: "2026-10-12T08:00:00.000Z", CompletedAt: "2026-10-15T08:00:00.000Z"},
+		{ID: 5, Name: "Stale", ProjectID: 20, CreatedAt: "2026-08-01T08:00:00.000Z", UpdatedAt: "2026-09-20T08:00:00.000Z"},
+		{ID: 6, Name: "Staler", ProjectID: 20, CreatedAt: "2026-07-01T08:00:00.000Z"},
+		{ID: 7, Name: "Overdue", ProjectID: 10, DueDate: "2026-10-01", UpdatedAt: "2026-10-15T08:00:00.000Z"},
+		{ID: 8, Name: "Due Sunday", ProjectID: 10, DueDate: "2026-10-18", UpdatedAt: "2026-10-15T08:00:00.000Z"},
+		{ID: 9, Name: "Due next week", ProjectID: 10, DueDate: "2026-10-19", UpdatedAt: "2026-10-15T08:00:00.000Z"},
+		{ID: 10, Name: "Done late", ProjectID: 30, Status: 2, DueDate: "2026-10-01", CompletedAt: "2026-10-12T08:00:00.000Z"},
+	}
+
+	review := BuildWeeklyReview(tasks, projects, now, 0)
+
+	if review.WeekStart != "2026-10-12" || review.WeekEnd != "2026-10-18" {
+		t.Errorf("Expected week 2026-10-12 to 2026-10-18, got %s to %s", review.WeekStart, review.WeekEnd)
+	}
+	if review.StaleDays != DefaultStaleDays {
+		t.Errorf("Expected default stale days %d, got %d", DefaultStaleDays, review.StaleDays)
+	}
+
+	if review.CompletedCount != 3 || len(review.Completed) != 3 {
+		t.Fatalf("Expected 3 completed tasks in 3 projects, got %d in %+v", review.CompletedCount, review.Completed)
+	}
+	for i, expected := range []string{"Garden", "Home", "Work"} {
+		if review.Completed[i].ProjectName != expected {
+			t.Errorf("Expected completed group %d to be %s, got %s", i, expected, review.Completed[i].ProjectName)
+		}
+	}
+
+	if ids := taskIDs(review.Created); !equalIDs(ids, []int{4, 3}) {
+		t.Errorf("Expected created tasks [4 3], got %v", ids)
+	}
+	if ids := taskIDs(review.Stale); !equalIDs(ids, []int{6, 5}) {
+		t.Errorf("Expected stale tasks [6 5], got %v", ids)
+	}
+	if ids := taskIDs(review.Slipping); !equalIDs(ids, []int{7, 8}) {
+		t.Errorf("Expected slipping tasks [7 8], got %v", ids)
+	}
+	if review.CreatedCount != 2 || review.StaleCount != 2 || review.SlippingCount != 2 {
+		t.Errorf("Expected counts 2/2/2, got %d/%d/%d", review.CreatedCount, review.StaleCount, review.SlippingCount)
+	}
+
+	if len(review.ProjectsWithoutNextAction) != 1 || review.ProjectsWithoutNextAction[0].ID != 30 {
+		t.Errorf("Expected only Garden to have no next action, got %+v", review.ProjectsWithoutNextAction)
+	}
+}
+
+func TestBuildWeeklyReviewUsesLocation(t *testing.T) {
+	// Sunday 23:30 five hours west of UTC is already Monday in UTC
+	west := time.FixedZone("UTC-5", -5*60*60)
+	now := time.Date(2026, time.October, 18, 23, 30, 0, 0, west)
+
+	tasks := []Task{
+		{ID: 1, Name: "Late Sunday", Status: 2, CompletedAt: "2026-10-19T03:00:00.000Z"},
+	}
+
+	review := BuildWeeklyReview(tasks, nil, now, 7)
+	if review.WeekStart != "2026-10-12" {
+		t.Errorf("Expected week starting 2026-10-12, got %s", review.WeekStart)
+	}
+	if review.CompletedCount != 1 {
+		t.Errorf("Expected completion at 22:00 local Sunday to count this week, got %d", review.CompletedCount)
+	}
+}
+
+func TestLocalDate(t *testing.T) {
+	west := time.FixedZone("UTC-5", -5*60*60)
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"2026-10-17T02:00:00.000Z", "2026-10-16"},
+		{"2026-10-17 02:00:00", "2026-10-16"},
+		{"2026-10-17", "2026-10-17"},
+		{"", ""},
+		{"not a date", ""},
+	}
+
+	for _, tt := range tests {
+		if got := localDate(tt.value, west); got != tt.expected {
+			t.Errorf("localDate(%q) = %q, expected %q", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestCompletedOn(t *testing.T) {
+	west := time.FixedZone("UTC-5", -5*60*60)
+	day := time.Date(2026, time.October, 15, 0, 0, 0, 0, west)
+
+	tasks := []Task{
+		{ID: 1, Status: 2, CompletedAt: "2026-10-15T14:00:00.000Z"},
+		{ID: 2, Status: 2, CompletedAt: "2026-10-16T03:00:00.000Z"},
+		{ID: 3, Status: 2, CompletedAt: "2026-10-15T03:00:00.000Z"},
+		{ID: 4, Status: 0, CompletedAt: "2026-10-15T14:00:00.000Z"},
+	}
+
+	if ids := taskIDs(CompletedOn(tasks, day)); !equalIDs(ids, []int{1, 2}) {
+		t.Errorf("Expected tasks [1 2] completed on 2026-10-15 in UTC-5, got %v", ids)
+	}
+}
@@ -0,0 +1,150 @@
+package tudidi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Relevance weights for a single search term. Name matches dominate, tags
+// come next and notes only nudge the ranking.
+const (
+	scoreNameExact      = 20
+	scoreNameWordPrefix = 15
+	scoreNameContains   = 10
+	scoreTagExact       = 8
+	scoreTagContains    = 5
+	scoreNoteContains   = 2
+)
+
+// SearchTasks returns the tasks matching every term of the query, most relevant first.
+// Terms are matched case-insensitively against the task name, note and tag names;
+// double quotes group words into a phrase, e.g. `"annual report" invoice`.
+func (api *API) SearchTasks(query string) ([]Task, error) {
+	terms := parseSearchQuery(query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+
+	tasks, err := api.GetTasks(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankTasks(tasks, terms), nil
+}
+
+// parseSearchQuery splits a query into lowercase terms, keeping quoted phrases
+// together. An unterminated quote runs to the end of the query.
+func parseSearchQuery(query string) []string {
+	var terms []string
+	var current strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if term := strings.TrimSpace(current.String()); term != "" {
+			terms = append(terms, strings.ToLower(term))
+		}
+		current.Reset()
+	}
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			flush()
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return terms
+}
+
+type rankedTask struct {
+	task  Task
+	score int
+}
+
+// rankTasks keeps the tasks matching all terms and orders them by score, then ID
+func rankTasks(tasks []Task, terms []string) []Task {
+	var ranked []rankedTask
+	for _, task := range tasks {
+		total := 0
+		matched := true
+		for _, term := range terms {
+			score := scoreTerm(task, term)
+			if score == 0 {
+				matched = false
+				break
+			}
+			total += score
+		}
+		if matched {
+			ranked = append(ranked, rankedTask{task: task, score: total})
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].task.ID < ranked[j].task.ID
+	})
+
+	results := make([]Task, len(ranked))
+	for i, r := range ranked {
+		results[i] = r.task
+	}
+	return results
+}
+
+// scoreTerm returns how well a single term matches the task, or 0 if it doesn't
+func scoreTerm(task Task, term string) int {
+	score := 0
+
+	name := strings.ToLower(task.Name)
+	switch {
+	case name == term:
+		score += scoreNameExact
+	case hasWordPrefix(name, term):
+		score += scoreNameWordPrefix
+	case strings.Contains(name, term):
+		score += scoreNameContains
+	}
+
+	tagScore := 0
+	for _, tag := range task.Tags {
+		tagName := strings.ToLower(tag.Name)
+		if tagName == term || strings.TrimPrefix(tagName, "@") == term {
+			tagScore = max(tagScore, scoreTagExact)
+		} else if strings.Contains(tagName, term) {
+			tagScore = max(tagScore, scoreTagContains)
+		}
+	}
+	score += tagScore
+
+	if strings.Contains(strings.ToLower(task.Note), term) {
+		score += scoreNoteContains
+	}
+
+	return score
+}
+
+// hasWordPrefix reports whether any word of text starts with term
+func hasWordPrefix(text, term string) bool {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	// Phrases span several words, so fall back to matching at the start of the text
+	return strings.HasPrefix(text, term)
+}
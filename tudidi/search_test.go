@@ -0,0 +1,87 @@
+package tudidi
+
+import (
+	"testing"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"Empty", "   ", nil},
+		{"Single term", "Invoice", []string{"invoice"}},
+		{"Multiple terms", "pay  the\tinvoice", []string{"pay", "the", "invoice"}},
+		{"Quoted phrase", `"annual report" draft`, []string{"annual report", "draft"}},
+		{"Quote inside word", `report"final draft"`, []string{"report", "final draft"}},
+		{"Unterminated quote", `call "the plumber`, []string{"call", "the plumber"}},
+		{"Empty quotes", `"" x`, []string{"x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms := parseSearchQuery(tt.query)
+			if len(terms) != len(tt.expected) {
+				t.Fatalf("Expected terms %q, got %q", tt.expected, terms)
+			}
+			for i := range terms {
+				if terms[i] != tt.expected[i] {
+					t.Fatalf("Expected terms %q, got %q", tt.expected, terms)
+				}
+			}
+		})
+	}
+}
+
+func TestRankTasks(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Name: "Review budget", Note: "Ask finance about the invoice"},
+		{ID: 2, Name: "Pay invoice", Tags: []Tag{{Name: "@finance"}}},
+		{ID: 3, Name: "Invoice"},
+		{ID: 4, Name: "Send reinvoiced items", Note: "annual report attached"},
+		{ID: 5, Name: "Draft the annual report"},
+		{ID: 6, Name: "Groceries", Tags: []Tag{{Name: "errands"}}},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []int
+	}{
+		{"Name beats note", "invoice", []int{3, 2, 4, 1}},
+		{"Terms are ANDed", "invoice finance", []int{2, 1}},
+		{"Case-insensitive", "GROCERIES", []int{6}},
+		{"Tag match", "errands", []int{6}},
+		{"Tag without @", "finance", []int{2, 1}},
+		{"Phrase", `"annual report"`, []int{5, 4}},
+		{"Phrase words out of order do not match", `"report annual"`, nil},
+		{"No match", "dentist", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := rankTasks(tasks, parseSearchQuery(tt.query))
+
+			var ids []int
+			for _, task := range ranked {
+				ids = append(ids, task.ID)
+			}
+			if len(ids) != len(tt.expected) {
+				t.Fatalf("Expected tasks %v, got %v", tt.expected, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.expected[i] {
+					t.Fatalf("Expected tasks %v, got %v", tt.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchTasksValidation(t *testing.T) {
+	api := &API{}
+	if _, err := api.SearchTasks(` "" `); err == nil || err.Error() != "query cannot be empty" {
+		t.Fatalf("Expected empty query error, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package tudidi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type TaskSortKey string
+
+const (
+	SortByDueDate  TaskSortKey = "due_date"
+	SortByPriority TaskSortKey = "priority"
+	SortByCreated  TaskSortKey = "created"
+	SortByUpdated  TaskSortKey = "updated"
+	SortByName     TaskSortKey = "name"
+	SortByProject  TaskSortKey = "project"
+
+	// sortByID is the fallback order; it is not offered to callers
+	sortByID TaskSortKey = "id"
+)
+
+// sortKeyAliases accepts both our key names and the column names Tudidi
+// uses in a project's TaskSortOrder, e.g. "created_at:desc"
+var sortKeyAliases = map[string]TaskSortKey{
+	"due_date":   SortByDueDate,
+	"due":        SortByDueDate,
+	"priority":   SortByPriority,
+	"created":    SortByCreated,
+	"created_at": SortByCreated,
+	"updated":    SortByUpdated,
+	"updated_at": SortByUpdated,
+	"name":       SortByName,
+	"project":    SortByProject,
+	"project_id": SortByProject,
+}
+
+// TaskSort orders tasks by Key; the zero value keeps the server's order
+type TaskSort struct {
+	Key        TaskSortKey
+	Descending bool
+}
+
+// ParseTaskSort parses a sort in Tudidi's "key:direction" format, e.g. "due_date:asc".
+// The direction is optional and defaults to ascending.
+func ParseTaskSort(value string) (TaskSort, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return TaskSort{}, nil
+	}
+
+	field, direction, _ := strings.Cut(value, ":")
+	key, ok := sortKeyAliases[strings.TrimSpace(field)]
+	if !ok {
+		return TaskSort{}, fmt.Errorf("invalid sort key '%s': must be one of due_date, priority, created, updated, name, project", field)
+	}
+
+	switch strings.TrimSpace(direction) {
+	case "", "asc":
+		return TaskSort{Key: key}, nil
+	case "desc":
+		return TaskSort{Key: key, Descending: true}, nil
+	default:
+		return TaskSort{}, fmt.Errorf("invalid sort direction '%s': must be asc or desc", direction)
+	}
+}
+
+// SortTasks sorts tasks in place. Ties are broken by task ID so the order is
+// stable between calls. Tasks missing the sort value (no due date, no project)
+// always come last, whatever the direction. Without project names, SortByProject
+// falls back to project ID; GetTasks sorts by name.
+func SortTasks(tasks []Task, order TaskSort) {
+	sortTasks(tasks, order, nil)
+}
+
+// sortTasks is SortTasks with the project names SortByProject orders by
+func sortTasks(tasks []Task, order TaskSort, names projectNameIndex) {
+	if order.Key == "" {
+		return
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+
+		if aMissing, bMissing := missingSortValue(a, order.Key), missingSortValue(b, order.Key); aMissing != bMissing {
+			return bMissing
+		}
+
+		cmp := compareTasks(a, b, order.Key, names)
+		if cmp == 0 {
+			return a.ID < b.ID
+		}
+		if order.Descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
+
+func missingSortValue(task Task, key TaskSortKey) bool {
+	switch key {
+	case SortByDueDate:
+		return task.DueDate == ""
+	case SortByProject:
+		return task.ProjectID == 0
+	}
+	return false
+}
+
+func compareTasks(a, b Task, key TaskSortKey, names projectNameIndex) int {
+	switch key {
+	case SortByDueDate:
+		return strings.Compare(DateOnly(a.DueDate), DateOnly(b.DueDate))
+	case SortByPriority:
+		return a.Priority - b.Priority
+	case SortByCreated:
+		return strings.Compare(a.CreatedAt, b.CreatedAt)
+	case SortByUpdated:
+		return strings.Compare(a.UpdatedAt, b.UpdatedAt)
+	case SortByName:
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case SortByProject:
+		if cmp := strings.Compare(strings.ToLower(names[a.ProjectID]), strings.ToLower(names[b.ProjectID])); cmp != 0 {
+			return cmp
+		}
+		return a.ProjectID - b.ProjectID
+	case sortByID:
+		return a.ID - b.ID
+	}
+	return 0
+}
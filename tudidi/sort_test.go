@@ -0,0 +1,119 @@
+package tudidi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTaskSort(t *testing.T) {
+	tests := []struct {
+		input         string
+		expected      TaskSort
+		errorContains string
+	}{
+		{"", TaskSort{}, ""},
+		{"due_date", TaskSort{Key: SortByDueDate}, ""},
+		{"priority:desc", TaskSort{Key: SortByPriority, Descending: true}, ""},
+		{"created_at:desc", TaskSort{Key: SortByCreated, Descending: true}, ""},
+		{"Name:ASC", TaskSort{Key: SortByName}, ""},
+		{"id", TaskSort{}, "invalid sort key"},
+		{"name:sideways", TaskSort{}, "invalid sort direction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			order, err := ParseTaskSort(tt.input)
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Expected error containing '%s', got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if order != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, order)
+			}
+		})
+	}
+}
+
+func TestSortTasks(t *testing.T) {
+	tasks := func() []Task {
+		return []Task{
+			{ID: 4, Name: "delta", Priority: 0, DueDate: "", ProjectID: 2, CreatedAt: "2026-10-04T09:00:00Z"},
+			{ID: 2, Name: "Bravo", Priority: 2, DueDate: "2026-10-20", ProjectID: 0, CreatedAt: "2026-10-02T09:00:00Z"},
+			{ID: 3, Name: "charlie", Priority: 2, DueDate: "2026-10-18T00:00:00.000Z", ProjectID: 1, CreatedAt: "2026-10-03T09:00:00Z"},
+			{ID: 1, Name: "alpha", Priority: 1, DueDate: "2026-10-20", ProjectID: 1, CreatedAt: "2026-10-01T09:00:00Z"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		order    TaskSort
+		expected []int
+	}{
+		{"Zero sort keeps order", TaskSort{}, []int{4, 2, 3, 1}},
+		{"By ID", TaskSort{Key: sortByID}, []int{1, 2, 3, 4}},
+		{"Due date ascending, missing last, ties by ID", TaskSort{Key: SortByDueDate}, []int{3, 1, 2, 4}},
+		{"Due date descending, missing still last", TaskSort{Key: SortByDueDate, Descending: true}, []int{1, 2, 3, 4}},
+		{"Priority descending", TaskSort{Key: SortByPriority, Descending: true}, []int{2, 3, 1, 4}},
+		{"Name is case-insensitive", TaskSort{Key: SortByName}, []int{1, 2, 3, 4}},
+		{"Created descending", TaskSort{Key: SortByCreated, Descending: true}, []int{4, 3, 2, 1}},
+		{"Project, no project last", TaskSort{Key: SortByProject}, []int{1, 3, 4, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := tasks()
+			SortTasks(sorted, tt.order)
+
+			for i, task := range sorted {
+				if task.ID != tt.expected[i] {
+					var ids []int
+					for _, task := range sorted {
+						ids = append(ids, task.ID)
+					}
+					t.Fatalf("Expected order %v, got %v", tt.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestSortTasksByProjectName(t *testing.T) {
+	names := projectNames([]Project{{ID: 1, Name: "Zebra"}, {ID: 2, Name: "apple"}, {ID: 3, Name: "Mango"}})
+
+	tests := []struct {
+		name     string
+		order    TaskSort
+		expected []int
+	}{
+		{"Ascending, no project last", TaskSort{Key: SortByProject}, []int{2, 5, 3, 1, 4}},
+		{"Descending, no project still last", TaskSort{Key: SortByProject, Descending: true}, []int{1, 3, 2, 5, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := []Task{
+				{ID: 1, ProjectID: 1},
+				{ID: 2, ProjectID: 2},
+				{ID: 3, ProjectID: 3},
+				{ID: 4, ProjectID: 0},
+				{ID: 5, ProjectID: 2},
+			}
+			sortTasks(sorted, tt.order, names)
+
+			for i, task := range sorted {
+				if task.ID != tt.expected[i] {
+					var ids []int
+					for _, task := range sorted {
+						ids = append(ids, task.ID)
+					}
+					t.Fatalf("Expected order %v, got %v", tt.expected, ids)
+				}
+			}
+		})
+	}
+}
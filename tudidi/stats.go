@@ -0,0 +1,144 @@
+package tudidi
+
+import (
+	"time"
+)
+
+// TaskStats summarises completed and open tasks for a period
+type TaskStats struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// CompletedCount is the number of tasks completed between From and To
+	CompletedCount   int         `json:"completed_count"`
+	CompletedPerDay  []DateCount `json:"completed_per_day"`
+	CompletedPerWeek []DateCount `json:"completed_per_week"`
+
+	// AverageCompletionHours is the mean CompletedAt - CreatedAt of the tasks completed in the
+	// period that have both timestamps; CompletionSamples says how many that was
+	AverageCompletionHours float64 `json:"average_completion_hours"`
+	CompletionSamples      int     `json:"completion_samples"`
+
+	OpenCount      int            `json:"open_count"`
+	OpenByPriority map[string]int `json:"open_by_priority"`
+	OpenByProject  []ProjectCount `json:"open_by_project"`
+
+	// CurrentStreak counts consecutive days with at least one completion, ending today
+	// (or yesterday, so a day with nothing done yet doesn't break it); LongestStreak is
+	// the best run in the task history
+	CurrentStreak int `json:"current_streak"`
+	LongestStreak int `json:"longest_streak"`
+}
+
+// DateCount is a count for a day, or for the week starting on Date
+type DateCount struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+// ComputeTaskStats computes statistics for the days from since to now, inclusive.
+// Dates are taken in now's location and weeks start on Monday.
+func ComputeTaskStats(tasks []Task, projects []Project, since, now time.Time) TaskStats {
+	location := now.Location()
+	today := startOfDay(now)
+	from := startOfDay(since.In(location))
+	if from.After(today) {
+		from = today
+	}
+
+	stats := TaskStats{
+		From:           from.Format("2006-01-02"),
+		To:             today.Format("2006-01-02"),
+		OpenByPriority: map[string]int{},
+	}
+
+	perDay := make(map[string]int)
+	completionDays := make(map[string]bool)
+	openByProject := make(map[int]int)
+	var totalHours float64
+
+	for _, task := range tasks {
+		if !task.IsClosed() {
+			stats.OpenCount++
+			openByProject[task.ProjectID]++
+			if priority, err := PriorityFromCode(task.Priority); err == nil {
+				stats.OpenByPriority[string(priority)]++
+			}
+			continue
+		}
+
+		completedAt, ok := parseTimestamp(task.CompletedAt)
+		if !ok {
+			continue
+		}
+		day := completedAt.In(location).Format("2006-01-02")
+		completionDays[day] = true
+		if day < stats.From || day > stats.To {
+			continue
+		}
+
+		stats.CompletedCount++
+		perDay[day]++
+		if createdAt, ok := parseTimestamp(task.CreatedAt); ok && !completedAt.Before(createdAt) {
+			totalHours += completedAt.Sub(createdAt).Hours()
+			stats.CompletionSamples++
+		}
+	}
+
+	if stats.CompletionSamples > 0 {
+		stats.AverageCompletionHours = totalHours / float64(stats.CompletionSamples)
+	}
+
+	for day := from; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		stats.CompletedPerDay = append(stats.CompletedPerDay, DateCount{Date: date, Count: perDay[date]})
+
+		weekStart := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7)).Format("2006-01-02")
+		if n := len(stats.CompletedPerWeek); n == 0 || stats.CompletedPerWeek[n-1].Date != weekStart {
+			stats.CompletedPerWeek = append(stats.CompletedPerWeek, DateCount{Date: weekStart})
+		}
+		stats.CompletedPerWeek[len(stats.CompletedPerWeek)-1].Count += perDay[date]
+	}
+
+	names := projectNames(projects)
+	for projectID, count := range openByProject {
+		stats.OpenByProject = append(stats.OpenByProject, ProjectCount{
+			ProjectID:   projectID,
+			ProjectName: names.name(projectID),
+			Count:       count,
+		})
+	}
+	sortByProjectName(stats.OpenByProject, func(p ProjectCount) (string, int) { return p.ProjectName, p.ProjectID })
+
+	stats.CurrentStreak, stats.LongestStreak = completionStreaks(completionDays, today)
+	return stats
+}
+
+// completionStreaks returns the current and longest runs of consecutive days in days
+func completionStreaks(days map[string]bool, today time.Time) (current, longest int) {
+	for date := range days {
+		day, err := time.ParseInLocation("2006-01-02", date, today.Location())
+		if err != nil || days[day.AddDate(0, 0, -1).Format("2006-01-02")] {
+			continue
+		}
+		// day starts a run; walk forward to measure it
+		length := 0
+		for days[day.AddDate(0, 0, length).Format("2006-01-02")] {
+			length++
+		}
+		longest = max(longest, length)
+	}
+
+	end := today
+	if !days[end.Format("2006-01-02")] {
+		end = end.AddDate(0, 0, -1)
+	}
+	for days[end.AddDate(0, 0, -current).Format("2006-01-02")] {
+		current++
+	}
+	return current, longest
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
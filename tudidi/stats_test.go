@@ -0,0 +1,117 @@
+package tudidi
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestComputeTaskStats(t *testing.T) {
+	// Friday 16 October 2026; the period starts on Sunday the 11th
+	now := time.Date(2026, time.October, 16, 18, 0, 0, 0, time.UTC)
+	since := time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC)
+
+	projects := []Project{{ID: 10, Name: "Work"}, {ID: 20, Name: "Home"}}
+	tasks := []Task{
+		{ID: 1, Status: 2, CreatedAt: "2026-10-10T10:00:00.000Z", CompletedAt: "2026-10-11T10:00:00.000Z"},
+		{ID: 2, Status: 2, CreatedAt: "2026-10-12T10:00:00.000Z", CompletedAt: "2026-10-12T22:00:00.000Z"},
+		{ID: 3, Status: 2, CompletedAt: "2026-10-14T09:00:00.000Z"},
+		{ID: 4, Status: 3, CreatedAt: "2026-10-01T09:00:00.000Z", CompletedAt: "2026-10-15T09:00:00.000Z"},
+		{ID: 5, Status: 2, CreatedAt: "2026-10-01T09:00:00.000Z", CompletedAt: "2026-10-05T09:00:00.000Z"},
+		{ID: 6, Status: 2, CreatedAt: "2026-10-01T09:00:00.000Z"},
+		{ID: 7, ProjectID: 10, Priority: 2},
+		{ID: 8, ProjectID: 10, Priority: 0},
+		{ID: 9, ProjectID: 20, Priority: 2},
+		{ID: 10, Priority: 1},
+	}
+
+	stats := ComputeTaskStats(tasks, projects, since, now)
+
+	if stats.From != "2026-10-11" || stats.To != "2026-10-16" {
+		t.Errorf("Expected period 2026-10-11 to 2026-10-16, got %s to %s", stats.From, stats.To)
+	}
+	if stats.CompletedCount != 4 {
+		t.Errorf("Expected 4 completed tasks, got %d", stats.CompletedCount)
+	}
+
+	expectedDays := []DateCount{
+		{"2026-10-11", 1}, {"2026-10-12", 1}, {"2026-10-13", 0},
+		{"2026-10-14", 1}, {"2026-10-15", 1}, {"2026-10-16", 0},
+	}
+	if len(stats.CompletedPerDay) != len(expectedDays) {
+		t.Fatalf("Expected per-day counts %v, got %v", expectedDays, stats.CompletedPerDay)
+	}
+	for i := range expectedDays {
+		if stats.CompletedPerDay[i] != expectedDays[i] {
+			t.Errorf("Expected %v, got %v", expectedDays[i], stats.CompletedPerDay[i])
+		}
+	}
+
+	expectedWeeks := []DateCount{{"2026-10-05", 1}, {"2026-10-12", 3}}
+	if len(stats.CompletedPerWeek) != 2 || stats.CompletedPerWeek[0] != expectedWeeks[0] || stats.CompletedPerWeek[1] != expectedWeeks[1] {
+		t.Errorf("Expected per-week counts %v, got %v", expectedWeeks, stats.CompletedPerWeek)
+	}
+
+	// 24h, 12h and 336h; task 3 has no creation time
+	if stats.CompletionSamples != 3 || math.Abs(stats.AverageCompletionHours-124) > 0.001 {
+		t.Errorf("Expected 124h average over 3 tasks, got %.2fh over %d", stats.AverageCompletionHours, stats.CompletionSamples)
+	}
+
+	if stats.OpenCount != 4 {
+		t.Errorf("Expected 4 open tasks, got %d", stats.OpenCount)
+	}
+	if stats.OpenByPriority["high"] != 2 || stats.OpenByPriority["medium"] != 1 || stats.OpenByPriority["low"] != 1 {
+		t.Errorf("Unexpected open tasks by priority: %v", stats.OpenByPriority)
+	}
+	expectedProjects := []ProjectCount{
+		{ProjectID: 20, ProjectName: "Home", Count: 1},
+		{ProjectID: 10, ProjectName: "Work", Count: 2},
+		{ProjectID: 0, ProjectName: NoProjectName, Count: 1},
+	}
+	if len(stats.OpenByProject) != len(expectedProjects) {
+		t.Fatalf("Expected open by project %v, got %v", expectedProjects, stats.OpenByProject)
+	}
+	for i := range expectedProjects {
+		if stats.OpenByProject[i] != expectedProjects[i] {
+			t.Errorf("Expected %v, got %v", expectedProjects[i], stats.OpenByProject[i])
+		}
+	}
+
+	// Nothing completed today yet, so the streak runs back from yesterday: 14th and 15th
+	if stats.CurrentStreak != 2 {
+		t.Errorf("Expected current streak 2, got %d", stats.CurrentStreak)
+	}
+	if stats.LongestStreak != 2 {
+		t.Errorf("Expected longest streak 2, got %d", stats.LongestStreak)
+	}
+}
+
+func TestCompletionStreaks(t *testing.T) {
+	today := time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		days             []string
+		current, longest int
+	}{
+		{"No completions", nil, 0, 0},
+		{"Today only", []string{"2026-10-16"}, 1, 1},
+		{"Run ending today", []string{"2026-10-14", "2026-10-15", "2026-10-16"}, 3, 3},
+		{"Broken yesterday", []string{"2026-10-13", "2026-10-14"}, 0, 2},
+		{"Longer run in the past", []string{"2026-09-01", "2026-09-02", "2026-09-03", "2026-09-04", "2026-10-15"}, 1, 4},
+		{"Across a month boundary", []string{"2026-09-30", "2026-10-01", "2026-10-02"}, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := make(map[string]bool)
+			for _, day := range tt.days {
+				days[day] = true
+			}
+			current, longest := completionStreaks(days, today)
+			if current != tt.current || longest != tt.longest {
+				t.Errorf("Expected current %d and longest %d, got %d and %d", tt.current, tt.longest, current, longest)
+			}
+		})
+	}
+}
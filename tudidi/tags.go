@@ -0,0 +1,112 @@
+package tudidi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+func (api *API) GetTags() ([]Tag, error) {
+	var tags []Tag
+	if err := api.doGet("/api/tags", &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
+}
+
+func (api *API) CreateTag(name string) (*Tag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	var tag Tag
+	if err := api.doPost("/api/tag", TagRequest{Name: name}, &tag); err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return &tag, nil
+}
+
+func (api *API) RenameTag(id int, name string) (*Tag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	var tag Tag
+	endpoint := "/api/tag/" + strconv.Itoa(id)
+	if err := api.doPatch(endpoint, TagRequest{Name: name}, &tag); err != nil {
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+	return &tag, nil
+}
+
+func (api *API) DeleteTag(id int) error {
+	endpoint := "/api/tag/" + strconv.Itoa(id)
+	if err := api.doDelete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// TagTask attaches the named tag to a task. Tudidi creates the tag on the fly
+// if it does not exist yet. Tagging a task that already carries the tag is a no-op.
+func (api *API) TagTask(taskID int, name string) (*Task, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	task, err := api.GetTask(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task with id %d not found: %w", taskID, err)
+	}
+
+	if task.HasTag(name) {
+		return task, nil
+	}
+	task.Tags = append(task.Tags, Tag{Name: name})
+
+	return api.patchTask(task, taskClears{})
+}
+
+// UntagTask removes the named tag from a task. The tag itself is kept.
+func (api *API) UntagTask(taskID int, name string) (*Task, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	task, err := api.GetTask(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task with id %d not found: %w", taskID, err)
+	}
+
+	if !task.HasTag(name) {
+		return nil, fmt.Errorf("task %d is not tagged with '%s'", taskID, name)
+	}
+
+	tags := make([]Tag, 0, len(task.Tags))
+	for _, tag := range task.Tags {
+		if !strings.EqualFold(tag.Name, name) {
+			tags = append(tags, tag)
+		}
+	}
+	task.Tags = tags
+
+	return api.patchTask(task, taskClears{})
+}
+
+// HasTag reports whether the task carries a tag with the given name (case-insensitive).
+func (t Task) HasTag(name string) bool {
+	for _, tag := range t.Tags {
+		if strings.EqualFold(tag.Name, name) {
+			return true
+		}
+	}
+	return false
+}
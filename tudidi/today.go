@@ -0,0 +1,148 @@
+package tudidi
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TodayView groups the open tasks that need attention on a given day. Each
+// task appears in exactly one group, the first that applies.
+type TodayView struct {
+	Date     string `json:"date"`
+	Overdue  []Task `json:"overdue"`
+	DueToday []Task `json:"due_today"`
+	Flagged  []Task `json:"flagged"`
+}
+
+// Count returns the number of tasks in the view
+func (v TodayView) Count() int {
+	return len(v.Overdue) + len(v.DueToday) + len(v.Flagged)
+}
+
+// BuildTodayView sorts open tasks into overdue, due today and flagged for Today
+// relative to today (YYYY-MM-DD). Tasks matching none of these are left out.
+func BuildTodayView(tasks []Task, today string) TodayView {
+	view := TodayView{Date: today}
+	for _, task := range tasks {
+		if task.IsClosed() {
+			continue
+		}
+		due := DateOnly(task.DueDate)
+		switch {
+		case due != "" && due < today:
+			view.Overdue = append(view.Overdue, task)
+		case due == today:
+			view.DueToday = append(view.DueToday, task)
+		case task.Today:
+			view.Flagged = append(view.Flagged, task)
+		}
+	}
+
+	SortTasks(view.Overdue, TaskSort{Key: SortByDueDate})
+	SortTasks(view.DueToday, TaskSort{Key: SortByPriority, Descending: true})
+	SortTasks(view.Flagged, TaskSort{Key: SortByPriority, Descending: true})
+	return view
+}
+
+// PlannedTask is a task proposed for the day together with why it was picked
+type PlannedTask struct {
+	Task    Task     `json:"task"`
+	Score   int      `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// Weights used by PlanDay. Deadlines outrank everything, then work already
+// committed to (the Today list, tasks in progress), then priority.
+const (
+	planOverdue        = 100
+	planDueToday       = 80
+	planOnToday        = 50
+	planInProgress     = 40
+	planDueSoon        = 30
+	planHighPriority   = 25
+	planMediumPriority = 10
+	planDueSoonDays    = 3
+)
+
+// PlanDay proposes up to limit open tasks to work on today (YYYY-MM-DD), most
+// pressing first. Low-priority tasks with no deadline in sight that are not
+// already started or on the Today list are never proposed.
+func PlanDay(tasks []Task, today string, limit int) []PlannedTask {
+	inProgress, _ := InProgress.Code()
+	high, _ := PriorityHigh.Code()
+	medium, _ := PriorityMedium.Code()
+
+	var plan []PlannedTask
+	for _, task := range tasks {
+		if task.IsClosed() {
+			continue
+		}
+
+		var item PlannedTask
+		add := func(score int, reason string) {
+			item.Score += score
+			item.Reasons = append(item.Reasons, reason)
+		}
+
+		if days, ok := daysBetween(today, DateOnly(task.DueDate)); ok {
+			switch {
+			case days < 0:
+				add(planOverdue+min(-days, 30), fmt.Sprintf("overdue by %s", pluralDays(-days)))
+			case days == 0:
+				add(planDueToday, "due today")
+			case days <= planDueSoonDays:
+				add(planDueSoon-5*(days-1), fmt.Sprintf("due in %s", pluralDays(days)))
+			}
+		}
+		if task.Today {
+			add(planOnToday, "already on the Today list")
+		}
+		if task.Status == inProgress {
+			add(planInProgress, "in progress")
+		}
+		switch task.Priority {
+		case high:
+			add(planHighPriority, "high priority")
+		case medium:
+			add(planMediumPriority, "medium priority")
+		}
+
+		if item.Score > 0 {
+			item.Task = task
+			plan = append(plan, item)
+		}
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool {
+		if plan[i].Score != plan[j].Score {
+			return plan[i].Score > plan[j].Score
+		}
+		return plan[i].Task.ID < plan[j].Task.ID
+	})
+
+	if limit > 0 && len(plan) > limit {
+		plan = plan[:limit]
+	}
+	return plan
+}
+
+// daysBetween returns the number of days from one YYYY-MM-DD date to another
+func daysBetween(from, to string) (int, bool) {
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return 0, false
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return 0, false
+	}
+	return int(end.Sub(start).Hours() / 24), true
+}
+
+func pluralDays(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
@@ -0,0 +1,107 @@
+package tudidi
+
+import (
+	"strings"
+	"testing"
+)
+
+func taskIDs(tasks []Task) []int {
+	var ids []int
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBuildTodayView(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Name: "Overdue, later", DueDate: "2026-10-14"},
+		{ID: 2, Name: "Overdue and flagged", DueDate: "2026-10-01T00:00:00.000Z", Today: true},
+		{ID: 3, Name: "Due today, low", DueDate: "2026-10-16", Priority: 0},
+		{ID: 4, Name: "Due today, high", DueDate: "2026-10-16T00:00:00.000Z", Priority: 2},
+		{ID: 5, Name: "Flagged", Today: true, DueDate: "2026-10-30"},
+		{ID: 6, Name: "Completed overdue", DueDate: "2026-10-01", Status: 2},
+		{ID: 7, Name: "Future", DueDate: "2026-10-20"},
+		{ID: 8, Name: "No date"},
+	}
+
+	view := BuildTodayView(tasks, "2026-10-16")
+
+	if view.Date != "2026-10-16" {
+		t.Errorf("Expected date 2026-10-16, got %s", view.Date)
+	}
+	if ids := taskIDs(view.Overdue); !equalIDs(ids, []int{2, 1}) {
+		t.Errorf("Expected overdue [2 1], got %v", ids)
+	}
+	if ids := taskIDs(view.DueToday); !equalIDs(ids, []int{4, 3}) {
+		t.Errorf("Expected due today [4 3], got %v", ids)
+	}
+	if ids := taskIDs(view.Flagged); !equalIDs(ids, []int{5}) {
+		t.Errorf("Expected flagged [5], got %v", ids)
+	}
+	if view.Count() != 5 {
+		t.Errorf("Expected count 5, got %d", view.Count())
+	}
+}
+
+func TestPlanDay(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Name: "Low, no date"},
+		{ID: 2, Name: "High, no date", Priority: 2},
+		{ID: 3, Name: "Overdue", DueDate: "2026-10-13"},
+		{ID: 4, Name: "Due today", DueDate: "2026-10-16"},
+		{ID: 5, Name: "Due in two days", DueDate: "2026-10-18"},
+		{ID: 6, Name: "In progress", Status: 1},
+		{ID: 7, Name: "On Today", Today: true},
+		{ID: 8, Name: "Completed overdue", DueDate: "2026-10-01", Status: 2},
+		{ID: 9, Name: "Medium, no date", Priority: 1},
+		{ID: 10, Name: "Due next month", DueDate: "2026-11-16"},
+	}
+
+	plan := PlanDay(tasks, "2026-10-16", 0)
+
+	var ids []int
+	for _, item := range plan {
+		ids = append(ids, item.Task.ID)
+	}
+	if expected := []int{3, 4, 7, 6, 2, 5, 9}; !equalIDs(ids, expected) {
+		t.Fatalf("Expected plan %v, got %v", expected, ids)
+	}
+	if reasons := strings.Join(plan[0].Reasons, ", "); reasons != "overdue by 3 days" {
+		t.Errorf("Expected overdue reason, got %q", reasons)
+	}
+	if reasons := strings.Join(plan[5].Reasons, ", "); reasons != "due in 2 days" {
+		t.Errorf("Expected due soon reason, got %q", reasons)
+	}
+
+	if limited := PlanDay(tasks, "2026-10-16", 2); len(limited) != 2 || limited[0].Task.ID != 3 {
+		t.Errorf("Expected the two most pressing tasks, got %v", limited)
+	}
+}
+
+func TestPlanDayCombinesReasons(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Name: "High and due today", DueDate: "2026-10-16", Priority: 2},
+		{ID: 2, Name: "Overdue", DueDate: "2026-10-15"},
+	}
+
+	plan := PlanDay(tasks, "2026-10-16", 0)
+	if len(plan) != 2 || plan[0].Task.ID != 1 {
+		t.Fatalf("Expected high-priority task due today first, got %v", plan)
+	}
+	if reasons := strings.Join(plan[0].Reasons, ", "); reasons != "due today, high priority" {
+		t.Errorf("Expected combined reasons, got %q", reasons)
+	}
+}